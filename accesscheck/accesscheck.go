@@ -0,0 +1,488 @@
+package accesscheck
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessionv2 "github.com/nspcc-dev/neofs-sdk-go/session/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// Request describes object operation request to check.
+type Request struct {
+	// Container the request is addressed to. Its owner and basic ACL are used.
+	Container container.Container
+	// ContainerID is an ID of the Container. Required.
+	ContainerID cid.ID
+	// EACL is an extended ACL of the Container. Nil means the container has no
+	// extended ACL.
+	EACL *eacl.Table
+
+	// Bearer is an optional bearer token attached to the request.
+	Bearer *bearer.Token
+	// Session is an optional object session token attached to the request.
+	// Must not be set together with SessionV2.
+	Session *session.Object
+	// SessionV2 is an optional V2 session token attached to the request. Must
+	// not be set together with Session.
+	SessionV2 *sessionv2.Token
+	// NNSResolver is used to check NNS subjects of the SessionV2. If nil, NNS
+	// subjects are ignored.
+	NNSResolver sessionv2.NNSResolver
+
+	// Op is the requested operation. Required.
+	Op acl.Op
+	// Role is the role of the request originator. If zero, it is resolved
+	// to [acl.RoleOwner] or [acl.RoleOthers] depending on whether the
+	// originator owns the Container. Storage and Inner Ring nodes must be
+	// specified explicitly.
+	Role acl.Role
+	// SenderKey is a binary public key of the request signer. Required.
+	SenderKey []byte
+
+	// Epoch is the current NeoFS epoch used to check Bearer and Session
+	// lifetime.
+	Epoch uint64
+	// Time is the current time used to check SessionV2 lifetime. Zero means
+	// [time.Now].
+	Time time.Time
+
+	// Object is a header of the object being accessed. It is used for
+	// object filters of the extended ACL, session object limits and sticky
+	// bit check. Nil means the header is unavailable, e.g. for search
+	// requests.
+	Object *object.Object
+	// XHeaders are string key-value pairs of the request X-headers used for
+	// request filters of the extended ACL. Must have even length.
+	XHeaders []string
+}
+
+// Stage enumerates stages of the access check.
+type Stage uint8
+
+const (
+	_ Stage = iota
+	StageSession
+	StageBasicACL
+	StageSticky
+	StageBearer
+	StageExtendedACL
+)
+
+// String implements [fmt.Stringer].
+func (x Stage) String() string {
+	switch x {
+	default:
+		return "UNKNOWN#" + strconv.FormatUint(uint64(x), 10)
+	case StageSession:
+		return "session"
+	case StageBasicACL:
+		return "basic ACL"
+	case StageSticky:
+		return "sticky bit"
+	case StageBearer:
+		return "bearer token"
+	case StageExtendedACL:
+		return "extended ACL"
+	}
+}
+
+// Step is a single stage of the access check.
+type Step struct {
+	// Stage of the check.
+	Stage Stage
+	// Allowed is true if the request passed the stage.
+	Allowed bool
+	// Reason is a human-readable description of the stage result.
+	Reason string
+	// Record is an index of the extended ACL record which produced the
+	// result of StageExtendedACL. Negative if the result is not produced by
+	// any record.
+	Record int
+}
+
+// String implements [fmt.Stringer].
+func (x Step) String() string {
+	res := "deny"
+	if x.Allowed {
+		res = "allow"
+	}
+	return fmt.Sprintf("%s: %s (%s)", x.Stage, res, x.Reason)
+}
+
+// Decision is the result of the access check.
+type Decision struct {
+	// Allowed is true if the request is allowed.
+	Allowed bool
+	// Trace lists all performed stages in order. The last one made the
+	// decision.
+	Trace []Step
+}
+
+// DecidedBy returns stage which made the decision. Zero Decision returns zero
+// Step.
+func (x Decision) DecidedBy() Step {
+	if len(x.Trace) == 0 {
+		return Step{}
+	}
+	return x.Trace[len(x.Trace)-1]
+}
+
+// String implements [fmt.Stringer].
+func (x Decision) String() string {
+	var sb strings.Builder
+	if x.Allowed {
+		sb.WriteString("allowed")
+	} else {
+		sb.WriteString("denied")
+	}
+	for i := range x.Trace {
+		sb.WriteString("\n\t")
+		sb.WriteString(x.Trace[i].String())
+	}
+	return sb.String()
+}
+
+type checker struct {
+	req Request
+	d   Decision
+
+	// request originator: session issuer or request signer
+	originator user.ID
+	// key used for extended ACL key targets
+	key []byte
+	// object ID if known
+	obj oid.ID
+}
+
+func (c *checker) step(s Stage, allowed bool, format string, args ...any) {
+	c.d.Trace = append(c.d.Trace, Step{Stage: s, Allowed: allowed, Reason: fmt.Sprintf(format, args...), Record: -1})
+}
+
+func (c *checker) deny(s Stage, format string, args ...any) Decision {
+	c.step(s, false, format, args...)
+	return c.d
+}
+
+func (c *checker) allow(s Stage, format string, args ...any) Decision {
+	c.step(s, true, format, args...)
+	c.d.Allowed = true
+	return c.d
+}
+
+// Check checks whether the request would be allowed by NeoFS storage nodes.
+// Returns an error if the request is incorrect, e.g. required fields are
+// missing. Check does not fail on access violations, they are reported in the
+// resulting Decision.
+//
+// The check is done in the following order:
+//  1. if session token is attached, it must be valid for the request;
+//  2. basic ACL must allow the operation for the request role;
+//  3. sticky basic ACL requires object owner to be the originator for PUT;
+//  4. extended ACL is applied unless basic ACL is final. If the bearer
+//     token is attached and allowed by the basic ACL, it must be valid, and
+//     its table replaces the container one.
+//
+// With session token, the request originator is the session issuer. Otherwise,
+// it is the request signer.
+func Check(req Request) (Decision, error) {
+	if req.Op < acl.OpObjectGet || req.Op > acl.OpObjectHash {
+		return Decision{}, fmt.Errorf("unsupported op %s", req.Op)
+	}
+	if req.Role > acl.RoleOthers {
+		return Decision{}, fmt.Errorf("unsupported role %s", req.Role)
+	}
+	if req.ContainerID.IsZero() {
+		return Decision{}, errors.New("missing container ID")
+	}
+	if len(req.SenderKey) == 0 {
+		return Decision{}, errors.New("missing sender key")
+	}
+	if len(req.XHeaders)%2 != 0 {
+		return Decision{}, errors.New("odd number of X-headers")
+	}
+	if req.Session != nil && req.SessionV2 != nil {
+		return Decision{}, errors.New("both session token versions are set")
+	}
+
+	var senderKey neofsecdsa.PublicKey
+	if err := senderKey.Decode(req.SenderKey); err != nil {
+		return Decision{}, fmt.Errorf("invalid sender key: %w", err)
+	}
+
+	c := checker{
+		req:        req,
+		originator: user.NewFromECDSAPublicKey(ecdsa.PublicKey(senderKey)),
+		key:        req.SenderKey,
+	}
+	if req.Object != nil {
+		c.obj = req.Object.GetID()
+	}
+
+	switch {
+	case req.Session != nil:
+		if reason := c.checkSession(&senderKey); reason != "" {
+			return c.deny(StageSession, "%s", reason), nil
+		}
+		c.originator = req.Session.Issuer()
+		if sig, ok := req.Session.Signature(); ok {
+			c.key = sig.PublicKeyBytes()
+		}
+		c.step(StageSession, true, "session token is valid, originator is %s", c.originator)
+	case req.SessionV2 != nil:
+		reason, err := c.checkSessionV2()
+		if err != nil {
+			return Decision{}, err
+		}
+		if reason != "" {
+			return c.deny(StageSession, "%s", reason), nil
+		}
+		c.originator = req.SessionV2.OriginalIssuer()
+		c.step(StageSession, true, "session token is valid, originator is %s", c.originator)
+	}
+
+	owner := req.Container.Owner()
+	role := req.Role
+	if role == 0 {
+		if c.originator == owner {
+			role = acl.RoleOwner
+		} else {
+			role = acl.RoleOthers
+		}
+	}
+
+	basic := req.Container.BasicACL()
+	if !basic.IsOpAllowed(req.Op, role) {
+		return c.deny(StageBasicACL, "%s is forbidden for %s by %s", req.Op, role, basic.EncodeToString()), nil
+	}
+	c.step(StageBasicACL, true, "%s is allowed for %s by %s", req.Op, role, basic.EncodeToString())
+
+	// sticky bit has no effect on container nodes for correct replication
+	if req.Op == acl.OpObjectPut && basic.Sticky() && role != acl.RoleContainer {
+		if req.Object == nil {
+			return c.deny(StageSticky, "object owner is unknown"), nil
+		}
+		if objOwner := req.Object.Owner(); objOwner != c.originator {
+			return c.deny(StageSticky, "object owner %s differs from originator %s", objOwner, c.originator), nil
+		}
+		c.step(StageSticky, true, "object owner is the originator")
+	}
+
+	if !basic.Extendable() {
+		return c.allow(StageBasicACL, "basic ACL is final, extended ACL is not applied"), nil
+	}
+
+	table := req.EACL
+	if req.Bearer != nil {
+		if !basic.AllowedBearerRules(req.Op) {
+			c.step(StageBearer, true, "bearer rules are not allowed for %s, token is ignored", req.Op)
+		} else {
+			if reason := c.checkBearer(owner); reason != "" {
+				return c.deny(StageBearer, "%s", reason), nil
+			}
+			t := req.Bearer.EACLTable()
+			table = &t
+			c.step(StageBearer, true, "bearer token is valid, its extended ACL is applied")
+		}
+	}
+
+	if table == nil {
+		return c.allow(StageExtendedACL, "no extended ACL"), nil
+	}
+
+	return c.checkEACL(table, role)
+}
+
+func (c *checker) checkSession(senderKey *neofsecdsa.PublicKey) string {
+	s := c.req.Session
+	switch {
+	case !s.VerifySignature():
+		return "invalid session token signature"
+	case !s.ValidAt(c.req.Epoch):
+		return fmt.Sprintf("session token is not valid at epoch %d", c.req.Epoch)
+	case !s.AssertContainer(c.req.ContainerID):
+		return "session token is bound to another container"
+	case !s.AssertVerb(sessionVerbs(c.req.Op)...):
+		return fmt.Sprintf("session token does not allow %s", c.req.Op)
+	case !c.obj.IsZero() && !s.AssertObject(c.obj):
+		return fmt.Sprintf("session token does not allow object %s", c.obj)
+	case !s.AssertAuthKey(senderKey):
+		return "request is not signed with the session key"
+	}
+	return ""
+}
+
+func (c *checker) checkSessionV2() (string, error) {
+	s := c.req.SessionV2
+	now := c.req.Time
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	if !s.VerifySignature() {
+		return "invalid session token signature", nil
+	}
+	if !s.ValidAt(now) {
+		return fmt.Sprintf("session token is not valid at %s", now), nil
+	}
+	var verbOK bool
+	for _, v := range sessionV2Verbs(c.req.Op) {
+		if verbOK = s.AssertVerb(v, c.req.ContainerID); verbOK {
+			break
+		}
+	}
+	if !verbOK {
+		return fmt.Sprintf("session token does not allow %s in the container", c.req.Op), nil
+	}
+	ok, err := s.AssertAuthority(c.originator, c.req.NNSResolver)
+	if err != nil {
+		return "", fmt.Errorf("check session token authority: %w", err)
+	}
+	if !ok {
+		return fmt.Sprintf("signer %s is not a session token subject", c.originator), nil
+	}
+	return "", nil
+}
+
+func (c *checker) checkBearer(owner user.ID) string {
+	b := c.req.Bearer
+	switch {
+	case !b.VerifySignature():
+		return "invalid bearer token signature"
+	case !b.ValidAt(c.req.Epoch):
+		return fmt.Sprintf("bearer token is not valid at epoch %d", c.req.Epoch)
+	case !b.AssertContainer(c.req.ContainerID):
+		return "bearer token is issued for another container"
+	case b.ResolveIssuer() != owner:
+		return "bearer token is not issued by the container owner"
+	case !b.AssertUser(c.originator):
+		return fmt.Sprintf("bearer token is not issued to %s", c.originator)
+	}
+	return ""
+}
+
+func (c *checker) checkEACL(table *eacl.Table, role acl.Role) (Decision, error) {
+	var eRole eacl.Role
+	switch role {
+	case acl.RoleOwner:
+		eRole = eacl.RoleUser
+	case acl.RoleContainer, acl.RoleInnerRing:
+		eRole = eacl.RoleSystem //nolint:staticcheck // still used by the validator
+	default:
+		eRole = eacl.RoleOthers
+	}
+
+	unit := new(eacl.ValidationUnit).
+		WithContainerID(&c.req.ContainerID).
+		WithRole(eRole).
+		WithOperation(eaclOperation(c.req.Op)).
+		WithHeaderSource(headerSource{req: &c.req}).
+		WithSenderKey(c.key).
+		WithAccount(c.originator).
+		WithEACLTable(table)
+
+	action, i, final, err := eacl.NewValidator().CalculateActionRecord(unit)
+	if err != nil {
+		return Decision{}, fmt.Errorf("calculate extended ACL action: %w", err)
+	}
+
+	var reason string
+	switch {
+	case i < 0:
+		reason = "no matching record, default action"
+	case !final:
+		reason = fmt.Sprintf("headers for filters of record #%d are unavailable", i)
+	default:
+		reason = fmt.Sprintf("record #%d matched", i)
+	}
+
+	c.d.Allowed = action == eacl.ActionAllow
+	c.d.Trace = append(c.d.Trace, Step{
+		Stage:   StageExtendedACL,
+		Allowed: c.d.Allowed,
+		Reason:  fmt.Sprintf("%s: %s", reason, action),
+		Record:  i,
+	})
+	return c.d, nil
+}
+
+func eaclOperation(op acl.Op) eacl.Operation {
+	switch op {
+	default:
+		return eacl.OperationUnspecified
+	case acl.OpObjectGet:
+		return eacl.OperationGet
+	case acl.OpObjectHead:
+		return eacl.OperationHead
+	case acl.OpObjectPut:
+		return eacl.OperationPut
+	case acl.OpObjectDelete:
+		return eacl.OperationDelete
+	case acl.OpObjectSearch:
+		return eacl.OperationSearch
+	case acl.OpObjectRange:
+		return eacl.OperationRange
+	case acl.OpObjectHash:
+		return eacl.OperationRangeHash
+	}
+}
+
+// returns session verbs allowing op like storage nodes do: some ops are also
+// allowed by sessions for ops that use them internally.
+func sessionVerbs(op acl.Op) []session.ObjectVerb {
+	switch op {
+	default:
+		return nil
+	case acl.OpObjectPut:
+		return []session.ObjectVerb{session.VerbObjectPut, session.VerbObjectDelete}
+	case acl.OpObjectDelete:
+		return []session.ObjectVerb{session.VerbObjectDelete}
+	case acl.OpObjectGet:
+		return []session.ObjectVerb{session.VerbObjectGet}
+	case acl.OpObjectHead:
+		return []session.ObjectVerb{session.VerbObjectHead, session.VerbObjectGet, session.VerbObjectDelete,
+			session.VerbObjectRange, session.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	case acl.OpObjectSearch:
+		return []session.ObjectVerb{session.VerbObjectSearch, session.VerbObjectDelete}
+	case acl.OpObjectRange:
+		return []session.ObjectVerb{session.VerbObjectRange, session.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	case acl.OpObjectHash:
+		return []session.ObjectVerb{session.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	}
+}
+
+// same as sessionVerbs for V2 tokens.
+func sessionV2Verbs(op acl.Op) []sessionv2.Verb {
+	switch op {
+	default:
+		return nil
+	case acl.OpObjectPut:
+		return []sessionv2.Verb{sessionv2.VerbObjectPut, sessionv2.VerbObjectDelete}
+	case acl.OpObjectDelete:
+		return []sessionv2.Verb{sessionv2.VerbObjectDelete}
+	case acl.OpObjectGet:
+		return []sessionv2.Verb{sessionv2.VerbObjectGet}
+	case acl.OpObjectHead:
+		return []sessionv2.Verb{sessionv2.VerbObjectHead, sessionv2.VerbObjectGet, sessionv2.VerbObjectDelete,
+			sessionv2.VerbObjectRange, sessionv2.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	case acl.OpObjectSearch:
+		return []sessionv2.Verb{sessionv2.VerbObjectSearch, sessionv2.VerbObjectDelete}
+	case acl.OpObjectRange:
+		return []sessionv2.Verb{sessionv2.VerbObjectRange, sessionv2.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	case acl.OpObjectHash:
+		return []sessionv2.Verb{sessionv2.VerbObjectRangeHash} //nolint:staticcheck // still may be used
+	}
+}
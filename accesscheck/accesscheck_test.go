@@ -0,0 +1,461 @@
+package accesscheck_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/accesscheck"
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	sessionv2 "github.com/nspcc-dev/neofs-sdk-go/session/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+const anyEpoch = 100
+
+type testEnv struct {
+	owner, other usertest.UserSigner
+	cnrID        cid.ID
+	cnr          container.Container
+}
+
+func newTestEnv(basicACL acl.Basic) testEnv {
+	env := testEnv{
+		owner: usertest.User(),
+		other: usertest.User(),
+		cnrID: cidtest.ID(),
+	}
+	env.cnr.Init()
+	env.cnr.SetOwner(env.owner.ID)
+	env.cnr.SetBasicACL(basicACL)
+	return env
+}
+
+func (x testEnv) request(op acl.Op, sender usertest.UserSigner) accesscheck.Request {
+	return accesscheck.Request{
+		Container:   x.cnr,
+		ContainerID: x.cnrID,
+		Op:          op,
+		SenderKey:   sender.PublicKeyBytes,
+		Epoch:       anyEpoch,
+	}
+}
+
+func (x testEnv) object(owner user.ID, attrs ...string) *object.Object {
+	var obj object.Object
+	obj.SetContainerID(x.cnrID)
+	obj.SetID(oidtest.ID())
+	obj.SetOwner(owner)
+	for i := 0; i < len(attrs); i += 2 {
+		obj.SetAttributes(append(obj.Attributes(), object.NewAttribute(attrs[i], attrs[i+1]))...)
+	}
+	return &obj
+}
+
+func (x testEnv) bearer(t testing.TB, issuer usertest.UserSigner, table eacl.Table) *bearer.Token {
+	var b bearer.Token
+	b.SetEACLTable(table)
+	b.SetIat(anyEpoch - 1)
+	b.SetNbf(anyEpoch - 1)
+	b.SetExp(anyEpoch + 1)
+	require.NoError(t, b.Sign(issuer))
+	return &b
+}
+
+func (x testEnv) session(t testing.TB, issuer, authorized usertest.UserSigner, verb session.ObjectVerb) *session.Object {
+	var s session.Object
+	s.BindContainer(x.cnrID)
+	s.ForVerb(verb)
+	s.SetAuthKey((*neofsecdsa.PublicKey)(&authorized.ECDSAPrivateKey.PublicKey))
+	s.SetIat(anyEpoch - 1)
+	s.SetNbf(anyEpoch - 1)
+	s.SetExp(anyEpoch + 1)
+	require.NoError(t, s.Sign(issuer))
+	return &s
+}
+
+func requireDecision(t testing.TB, req accesscheck.Request, allowed bool, stages ...accesscheck.Stage) accesscheck.Decision {
+	d, err := accesscheck.Check(req)
+	require.NoError(t, err)
+	require.Equal(t, allowed, d.Allowed, d.String())
+	require.Len(t, d.Trace, len(stages), d.String())
+	for i := range stages {
+		require.Equal(t, stages[i], d.Trace[i].Stage, d.String())
+		require.Equal(t, i < len(stages)-1 || allowed, d.Trace[i].Allowed, d.String())
+	}
+	require.Equal(t, d.Trace[len(d.Trace)-1], d.DecidedBy())
+	return d
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		env := newTestEnv(acl.PublicRWExtended)
+		for _, tc := range []struct {
+			name, err string
+			corrupt   func(*accesscheck.Request)
+		}{
+			{name: "zero op", err: "unsupported op UNKNOWN#0",
+				corrupt: func(r *accesscheck.Request) { r.Op = 0 }},
+			{name: "unknown op", err: "unsupported op UNKNOWN#8",
+				corrupt: func(r *accesscheck.Request) { r.Op = acl.OpObjectHash + 1 }},
+			{name: "unknown role", err: "unsupported role UNKNOWN#5",
+				corrupt: func(r *accesscheck.Request) { r.Role = acl.RoleOthers + 1 }},
+			{name: "missing container ID", err: "missing container ID",
+				corrupt: func(r *accesscheck.Request) { r.ContainerID = cid.ID{} }},
+			{name: "missing sender key", err: "missing sender key",
+				corrupt: func(r *accesscheck.Request) { r.SenderKey = nil }},
+			{name: "invalid sender key", err: "invalid sender key: invalid prefix 1",
+				corrupt: func(r *accesscheck.Request) { r.SenderKey = []byte{1, 2, 3} }},
+			{name: "odd X-headers", err: "odd number of X-headers",
+				corrupt: func(r *accesscheck.Request) { r.XHeaders = []string{"k"} }},
+			{name: "both sessions", err: "both session token versions are set",
+				corrupt: func(r *accesscheck.Request) {
+					r.Session = new(session.Object)
+					r.SessionV2 = new(sessionv2.Token)
+				}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				req := env.request(acl.OpObjectGet, env.other)
+				tc.corrupt(&req)
+				_, err := accesscheck.Check(req)
+				require.ErrorContains(t, err, tc.err)
+			})
+		}
+	})
+	t.Run("basic ACL", func(t *testing.T) {
+		env := newTestEnv(acl.Private)
+
+		d := requireDecision(t, env.request(acl.OpObjectGet, env.owner), true, accesscheck.StageBasicACL, accesscheck.StageBasicACL)
+		require.Equal(t, "OBJECT_GET is allowed for OWNER by 1c8c8ccc", d.Trace[0].Reason)
+		require.Equal(t, "basic ACL is final, extended ACL is not applied", d.Trace[1].Reason)
+
+		d = requireDecision(t, env.request(acl.OpObjectGet, env.other), false, accesscheck.StageBasicACL)
+		require.Equal(t, "OBJECT_GET is forbidden for OTHERS by 1c8c8ccc", d.DecidedBy().Reason)
+
+		t.Run("explicit role", func(t *testing.T) {
+			req := env.request(acl.OpObjectGet, env.other)
+			req.Role = acl.RoleContainer
+			requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageBasicACL)
+
+			req.Op = acl.OpObjectDelete
+			requireDecision(t, req, false, accesscheck.StageBasicACL)
+
+			req.Role = acl.RoleInnerRing
+			requireDecision(t, req, false, accesscheck.StageBasicACL)
+			req.Op = acl.OpObjectHead
+			requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageBasicACL)
+		})
+	})
+	t.Run("sticky bit", func(t *testing.T) {
+		basic := acl.PublicRW
+		basic.MakeSticky()
+		env := newTestEnv(basic)
+
+		req := env.request(acl.OpObjectPut, env.other)
+		d := requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageSticky)
+		require.Equal(t, "object owner is unknown", d.DecidedBy().Reason)
+
+		req.Object = env.object(env.owner.ID)
+		requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageSticky)
+
+		req.Object = env.object(env.other.ID)
+		requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageSticky, accesscheck.StageBasicACL)
+
+		// container nodes are not affected
+		req.Object = env.object(env.owner.ID)
+		req.Role = acl.RoleContainer
+		requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageBasicACL)
+	})
+	t.Run("extended ACL", func(t *testing.T) {
+		env := newTestEnv(acl.PublicRWExtended)
+		table := eacl.NewTableForContainer(env.cnrID, []eacl.Record{
+			eacl.ConstructRecord(eacl.ActionAllow, eacl.OperationGet, []eacl.Target{eacl.NewTargetByAccounts([]user.ID{env.other.ID})},
+				eacl.NewRequestHeaderFilter("x", eacl.MatchStringEqual, "y")),
+			eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationGet, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)},
+				eacl.NewObjectPropertyFilter("secret", eacl.MatchStringEqual, "true")),
+			eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationPut, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)}),
+		})
+
+		req := env.request(acl.OpObjectGet, env.other)
+
+		// no table
+		d := requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "no extended ACL", d.DecidedBy().Reason)
+
+		req.EACL = &table
+
+		// object headers are unavailable
+		d = requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "headers for filters of record #1 are unavailable: ALLOW", d.DecidedBy().Reason)
+		require.Equal(t, 1, d.DecidedBy().Record)
+
+		req.Object = env.object(env.owner.ID, "secret", "true")
+		d = requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "record #1 matched: DENY", d.DecidedBy().Reason)
+		require.Equal(t, 1, d.DecidedBy().Record)
+
+		req.XHeaders = []string{"x", "y"}
+		d = requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "record #0 matched: ALLOW", d.DecidedBy().Reason)
+		require.Zero(t, d.DecidedBy().Record)
+
+		req.Object = env.object(env.owner.ID, "secret", "false")
+		req.XHeaders = nil
+		d = requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "no matching record, default action: ALLOW", d.DecidedBy().Reason)
+		require.Negative(t, d.DecidedBy().Record)
+
+		req.Op = acl.OpObjectPut
+		d = requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, 2, d.DecidedBy().Record)
+
+		// owner is not affected by the rules for others
+		req = env.request(acl.OpObjectPut, env.owner)
+		req.EACL = &table
+		requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+
+		t.Run("search", func(t *testing.T) {
+			table := eacl.NewTableForContainer(env.cnrID, []eacl.Record{
+				eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationSearch, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)},
+					eacl.NewFilterObjectsFromContainer(env.cnrID)),
+			})
+			req := env.request(acl.OpObjectSearch, env.other)
+			req.EACL = &table
+			d := requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+			require.Equal(t, "record #0 matched: DENY", d.DecidedBy().Reason)
+		})
+	})
+	t.Run("bearer token", func(t *testing.T) {
+		env := newTestEnv(acl.PublicROExtended)
+		denyAll := eacl.NewTableForContainer(env.cnrID, []eacl.Record{
+			eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationGet, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)}),
+		})
+		allowOther := eacl.NewTableForContainer(env.cnrID, []eacl.Record{
+			eacl.ConstructRecord(eacl.ActionAllow, eacl.OperationGet, []eacl.Target{eacl.NewTargetByAccounts([]user.ID{env.other.ID})}),
+		})
+
+		req := env.request(acl.OpObjectGet, env.other)
+		req.EACL = &denyAll
+		requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+
+		req.Bearer = env.bearer(t, env.owner, allowOther)
+		d := requireDecision(t, req, true, accesscheck.StageBasicACL, accesscheck.StageBearer, accesscheck.StageExtendedACL)
+		require.Equal(t, "bearer token is valid, its extended ACL is applied", d.Trace[1].Reason)
+
+		t.Run("not allowed by basic ACL", func(t *testing.T) {
+			basic := acl.PublicROExtended
+			basic.FromBits(basic.Bits() &^ 1) // reset bearer bit for GET
+			env := env
+			env.cnr.SetBasicACL(basic)
+			req := req
+			req.Container = env.cnr
+			d := requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageBearer, accesscheck.StageExtendedACL)
+			require.Equal(t, "bearer rules are not allowed for OBJECT_GET, token is ignored", d.Trace[1].Reason)
+		})
+		t.Run("invalid", func(t *testing.T) {
+			for _, tc := range []struct {
+				name, reason string
+				corrupt      func(*accesscheck.Request)
+			}{
+				{name: "signature", reason: "invalid bearer token signature", corrupt: func(r *accesscheck.Request) {
+					b := *r.Bearer
+					b.SetExp(anyEpoch + 2)
+					r.Bearer = &b
+				}},
+				{name: "expired", reason: "bearer token is not valid at epoch 102", corrupt: func(r *accesscheck.Request) {
+					r.Epoch = anyEpoch + 2
+				}},
+				{name: "container", reason: "bearer token is issued for another container", corrupt: func(r *accesscheck.Request) {
+					r.Bearer = env.bearer(t, env.owner, eacl.NewTableForContainer(cidtest.OtherID(env.cnrID), allowOther.Records()))
+				}},
+				{name: "issuer", reason: "bearer token is not issued by the container owner", corrupt: func(r *accesscheck.Request) {
+					r.Bearer = env.bearer(t, env.other, allowOther)
+				}},
+				{name: "user", reason: "bearer token is not issued to " + env.other.ID.String(), corrupt: func(r *accesscheck.Request) {
+					b := *r.Bearer
+					b.ForUser(usertest.OtherID(env.other.ID))
+					require.NoError(t, b.Sign(env.owner))
+					r.Bearer = &b
+				}},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					req := req
+					tc.corrupt(&req)
+					d := requireDecision(t, req, false, accesscheck.StageBasicACL, accesscheck.StageBearer)
+					require.Equal(t, tc.reason, d.DecidedBy().Reason)
+				})
+			}
+		})
+	})
+	t.Run("session token", func(t *testing.T) {
+		env := newTestEnv(acl.PrivateExtended)
+		node := usertest.User()
+
+		// other user can not write to private container
+		req := env.request(acl.OpObjectPut, node)
+		requireDecision(t, req, false, accesscheck.StageBasicACL)
+
+		// but can on behalf of the owner
+		req.Session = env.session(t, env.owner, node, session.VerbObjectPut)
+		d := requireDecision(t, req, true, accesscheck.StageSession, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+		require.Equal(t, "session token is valid, originator is "+env.owner.ID.String(), d.Trace[0].Reason)
+		require.Equal(t, "OBJECT_PUT is allowed for OWNER by c8c8ccc", d.Trace[1].Reason)
+
+		// HEAD is allowed by GET session
+		req.Op = acl.OpObjectHead
+		req.Session = env.session(t, env.owner, node, session.VerbObjectGet)
+		requireDecision(t, req, true, accesscheck.StageSession, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+
+		t.Run("invalid", func(t *testing.T) {
+			for _, tc := range []struct {
+				name, reason string
+				corrupt      func(*accesscheck.Request)
+			}{
+				{name: "signature", reason: "invalid session token signature", corrupt: func(r *accesscheck.Request) {
+					s := *r.Session
+					s.SetExp(anyEpoch + 2)
+					r.Session = &s
+				}},
+				{name: "expired", reason: "session token is not valid at epoch 102", corrupt: func(r *accesscheck.Request) {
+					r.Epoch = anyEpoch + 2
+				}},
+				{name: "container", reason: "session token is bound to another container", corrupt: func(r *accesscheck.Request) {
+					r.ContainerID = cidtest.OtherID(env.cnrID)
+				}},
+				{name: "verb", reason: "session token does not allow OBJECT_DELETE", corrupt: func(r *accesscheck.Request) {
+					r.Op = acl.OpObjectDelete
+				}},
+				{name: "object", reason: "session token does not allow object", corrupt: func(r *accesscheck.Request) {
+					s := *r.Session
+					s.LimitByObjects(oidtest.ID())
+					require.NoError(t, s.Sign(env.owner))
+					r.Session = &s
+					r.Object = env.object(env.owner.ID)
+				}},
+				{name: "auth key", reason: "request is not signed with the session key", corrupt: func(r *accesscheck.Request) {
+					r.SenderKey = env.other.PublicKeyBytes
+				}},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					req := req
+					tc.corrupt(&req)
+					d := requireDecision(t, req, false, accesscheck.StageSession)
+					require.Contains(t, d.DecidedBy().Reason, tc.reason)
+				})
+			}
+		})
+	})
+	t.Run("session token V2", func(t *testing.T) {
+		env := newTestEnv(acl.PrivateExtended)
+		node := usertest.User()
+		now := time.Now()
+
+		var s sessionv2.Token
+		s.SetVersion(sessionv2.TokenCurrentVersion)
+		require.NoError(t, s.AddSubject(sessionv2.NewTargetUser(node.ID)))
+		s.SetIat(now.Add(-time.Hour))
+		s.SetNbf(now.Add(-time.Hour))
+		s.SetExp(now.Add(time.Hour))
+		ctx, err := sessionv2.NewContext(env.cnrID, []sessionv2.Verb{sessionv2.VerbObjectPut})
+		require.NoError(t, err)
+		require.NoError(t, s.AddContext(ctx))
+		require.NoError(t, s.Sign(env.owner))
+
+		req := env.request(acl.OpObjectPut, node)
+		req.SessionV2 = &s
+		req.Time = now
+		requireDecision(t, req, true, accesscheck.StageSession, accesscheck.StageBasicACL, accesscheck.StageExtendedACL)
+
+		t.Run("invalid", func(t *testing.T) {
+			for _, tc := range []struct {
+				name, reason string
+				corrupt      func(*accesscheck.Request)
+			}{
+				{name: "signature", reason: "invalid session token signature", corrupt: func(r *accesscheck.Request) {
+					var s sessionv2.Token
+					r.SessionV2.CopyTo(&s)
+					s.SetExp(now.Add(2 * time.Hour))
+					r.SessionV2 = &s
+				}},
+				{name: "expired", reason: "session token is not valid at", corrupt: func(r *accesscheck.Request) {
+					r.Time = now.Add(2 * time.Hour)
+				}},
+				{name: "verb", reason: "session token does not allow OBJECT_GET in the container", corrupt: func(r *accesscheck.Request) {
+					r.Op = acl.OpObjectGet
+				}},
+				{name: "container", reason: "session token does not allow OBJECT_PUT in the container", corrupt: func(r *accesscheck.Request) {
+					r.ContainerID = cidtest.OtherID(env.cnrID)
+				}},
+				{name: "subject", reason: "signer " + env.other.ID.String() + " is not a session token subject", corrupt: func(r *accesscheck.Request) {
+					r.SenderKey = env.other.PublicKeyBytes
+				}},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					req := req
+					tc.corrupt(&req)
+					d := requireDecision(t, req, false, accesscheck.StageSession)
+					require.Contains(t, d.DecidedBy().Reason, tc.reason)
+				})
+			}
+		})
+		t.Run("NNS failure", func(t *testing.T) {
+			var s sessionv2.Token
+			req.SessionV2.CopyTo(&s)
+			require.NoError(t, s.SetSubjects([]sessionv2.Target{sessionv2.NewTargetNamed("friends.neo")}))
+			require.NoError(t, s.Sign(env.owner))
+			req := req
+			req.SessionV2 = &s
+			req.NNSResolver = failingNNSResolver{}
+			_, err := accesscheck.Check(req)
+			require.ErrorIs(t, err, errNNS)
+		})
+	})
+}
+
+var errNNS = errors.New("any NNS error")
+
+type failingNNSResolver struct{}
+
+func (failingNNSResolver) HasUser(string, user.ID) (bool, error) { return false, errNNS }
+
+func TestDecision_String(t *testing.T) {
+	require.Equal(t, "denied", accesscheck.Decision{}.String())
+	require.Zero(t, accesscheck.Decision{}.DecidedBy())
+
+	d := accesscheck.Decision{
+		Allowed: true,
+		Trace: []accesscheck.Step{
+			{Stage: accesscheck.StageBasicACL, Allowed: true, Reason: "reason 1"},
+			{Stage: accesscheck.StageExtendedACL, Allowed: true, Reason: "reason 2"},
+		},
+	}
+	require.Equal(t, "allowed\n\tbasic ACL: allow (reason 1)\n\textended ACL: allow (reason 2)", d.String())
+}
+
+func TestStage_String(t *testing.T) {
+	for _, tc := range []struct {
+		s   accesscheck.Stage
+		exp string
+	}{
+		{0, "UNKNOWN#0"},
+		{accesscheck.StageSession, "session"},
+		{accesscheck.StageBasicACL, "basic ACL"},
+		{accesscheck.StageSticky, "sticky bit"},
+		{accesscheck.StageBearer, "bearer token"},
+		{accesscheck.StageExtendedACL, "extended ACL"},
+		{accesscheck.StageExtendedACL + 1, "UNKNOWN#6"},
+	} {
+		require.Equal(t, tc.exp, tc.s.String())
+	}
+}
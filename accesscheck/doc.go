@@ -0,0 +1,30 @@
+/*
+Package accesscheck provides offline simulation of the NeoFS object access
+control.
+
+The access to objects in NeoFS container is regulated by several mechanisms
+applied by storage nodes one after another: session tokens, basic ACL of the
+container, extended ACL of the container and bearer tokens. [Check] combines
+them the same way and answers whether the given request would be allowed:
+
+	d, err := accesscheck.Check(accesscheck.Request{
+		Container:   cnr,
+		ContainerID: cnrID,
+		EACL:        &table,
+		Op:          acl.OpObjectGet,
+		SenderKey:   pubKey,
+		Object:      &hdr,
+	})
+	// ...
+	if !d.Allowed {
+		fmt.Println("denied by", d.DecidedBy())
+	}
+
+The resulting [Decision] carries a trace of all performed checks, so it can be
+used to debug and unit-test container permission setups.
+
+Note that the simulation relies on the provided data only: it does not resolve
+anything from the network, e.g. the request role of the storage and Inner Ring
+nodes must be passed explicitly.
+*/
+package accesscheck
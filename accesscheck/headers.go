@@ -0,0 +1,82 @@
+package accesscheck
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+type stringHeader struct {
+	key, value string
+}
+
+func (x stringHeader) Key() string   { return x.key }
+func (x stringHeader) Value() string { return x.value }
+
+// headerSource provides request headers for extended ACL filters.
+type headerSource struct {
+	req *Request
+}
+
+// HeadersOfType implements [eacl.TypedHeaderSource].
+func (x headerSource) HeadersOfType(typ eacl.FilterHeaderType) ([]eacl.Header, bool, error) {
+	switch typ {
+	default:
+		return nil, false, nil
+	case eacl.HeaderFromRequest:
+		res := make([]eacl.Header, 0, len(x.req.XHeaders)/2)
+		for i := 0; i < len(x.req.XHeaders); i += 2 {
+			res = append(res, stringHeader{x.req.XHeaders[i], x.req.XHeaders[i+1]})
+		}
+		return res, true, nil
+	case eacl.HeaderFromObject:
+		if x.req.Object != nil {
+			return objectHeaders(*x.req.Object), true, nil
+		}
+		if x.req.Op == acl.OpObjectSearch {
+			// search requests are not bound to particular objects, but the
+			// container is still known
+			return []eacl.Header{stringHeader{eacl.FilterObjectContainerID, x.req.ContainerID.EncodeToString()}}, true, nil
+		}
+		return nil, false, nil
+	}
+}
+
+// objectHeaders returns object headers the same way storage nodes provide them
+// for extended ACL filters.
+func objectHeaders(obj object.Object) []eacl.Header {
+	attrs := obj.Attributes()
+	res := make([]eacl.Header, 0, 9+len(attrs))
+
+	if id := obj.GetID(); !id.IsZero() {
+		res = append(res, stringHeader{eacl.FilterObjectID, id.EncodeToString()})
+	}
+	if cnr := obj.GetContainerID(); !cnr.IsZero() {
+		res = append(res, stringHeader{eacl.FilterObjectContainerID, cnr.EncodeToString()})
+	}
+	if owner := obj.Owner(); !owner.IsZero() {
+		res = append(res, stringHeader{eacl.FilterObjectOwnerID, owner.EncodeToString()})
+	}
+	if ver := obj.Version(); ver != nil {
+		res = append(res, stringHeader{eacl.FilterObjectVersion, ver.String()})
+	}
+	res = append(res,
+		stringHeader{eacl.FilterObjectCreationEpoch, strconv.FormatUint(obj.CreationEpoch(), 10)},
+		stringHeader{eacl.FilterObjectPayloadSize, strconv.FormatUint(obj.PayloadSize(), 10)},
+		stringHeader{eacl.FilterObjectType, obj.Type().String()},
+	)
+	if cs, ok := obj.PayloadChecksum(); ok {
+		res = append(res, stringHeader{eacl.FilterObjectPayloadChecksum, hex.EncodeToString(cs.Value())})
+	}
+	if cs, ok := obj.PayloadHomomorphicHash(); ok {
+		res = append(res, stringHeader{eacl.FilterObjectPayloadHomomorphicChecksum, hex.EncodeToString(cs.Value())}) //nolint:staticcheck // still may be used
+	}
+	for i := range attrs {
+		res = append(res, stringHeader{attrs[i].Key(), attrs[i].Value()})
+	}
+
+	return res
+}
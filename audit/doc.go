@@ -0,0 +1,21 @@
+/*
+Package audit provides features to process data audit in NeoFS system.
+
+Result type groups values which can be gathered during data audit process:
+
+	var res audit.Result
+	res.SetVersion(version.Current())
+	res.SetEpoch(epoch)
+	res.SetContainer(cnr)
+	// fill the rest results
+	err := res.Sign(signer)
+	// ...
+
+	// send data
+	data := res.Marshal()
+	sig, _ := res.Signature()
+
+Note that the data audit mechanism is no longer supported by NeoFS since API
+2.18, so the package is mostly useful for processing historical data.
+*/
+package audit
@@ -0,0 +1,526 @@
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoaudit "github.com/nspcc-dev/neofs-sdk-go/proto/audit"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// Result represents report on the results of the data audit in NeoFS system.
+//
+// Result is mutually compatible with [protoaudit.DataAuditResult] message. See
+// [Result.FromProtoMessage] / [Result.ProtoMessage] methods. Note that the
+// message has no signature field, so [Result] signature is not encoded and
+// must be transmitted separately.
+//
+// Instances can be created using built-in var declaration.
+type Result struct {
+	versionSet bool
+	version    version.Version
+
+	epoch uint64
+
+	cnr cid.ID
+
+	auditorKey []byte
+
+	completed bool
+
+	requestsPoR, retriesPoR uint32
+
+	passSG, failSG []oid.ID
+
+	hits, misses, failures uint32
+
+	passNodes, failNodes [][]byte
+
+	sigSet bool
+	sig    neofscrypto.Signature
+}
+
+func storageGroupsFromProto(dst *[]oid.ID, ms []*refs.ObjectID) error {
+	if len(ms) == 0 {
+		*dst = nil
+		return nil
+	}
+
+	*dst = make([]oid.ID, len(ms))
+	for i := range ms {
+		if ms[i] == nil {
+			return fmt.Errorf("nil element #%d", i)
+		}
+		if err := (*dst)[i].FromProtoMessage(ms[i]); err != nil {
+			return fmt.Errorf("invalid element #%d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func storageGroupsToProto(ids []oid.ID) []*refs.ObjectID {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	ms := make([]*refs.ObjectID, len(ids))
+	for i := range ids {
+		ms[i] = ids[i].ProtoMessage()
+	}
+	return ms
+}
+
+// reads Result from the audit.DataAuditResult message. If checkFieldPresence
+// is set, returns an error on absence of any protocol-required field.
+func (r *Result) fromProtoMessage(m *protoaudit.DataAuditResult, checkFieldPresence bool) error {
+	var err error
+
+	if r.versionSet = m.Version != nil; r.versionSet {
+		if err = r.version.FromProtoMessage(m.Version); err != nil {
+			return fmt.Errorf("invalid version: %w", err)
+		}
+	} else if checkFieldPresence {
+		return errors.New("missing version")
+	} else {
+		r.version = version.Version{}
+	}
+
+	if m.ContainerId != nil {
+		if err = r.cnr.FromProtoMessage(m.ContainerId); err != nil {
+			return fmt.Errorf("invalid container: %w", err)
+		}
+	} else if checkFieldPresence {
+		return errors.New("missing container")
+	} else {
+		r.cnr = cid.ID{}
+	}
+
+	if err = storageGroupsFromProto(&r.passSG, m.PassSg); err != nil {
+		return fmt.Errorf("invalid passed storage groups: %w", err)
+	}
+	if err = storageGroupsFromProto(&r.failSG, m.FailSg); err != nil {
+		return fmt.Errorf("invalid failed storage groups: %w", err)
+	}
+
+	r.epoch = m.AuditEpoch
+	r.auditorKey = m.PublicKey
+	r.completed = m.Complete
+	r.requestsPoR = m.Requests
+	r.retriesPoR = m.Retries
+	r.hits = m.Hit
+	r.misses = m.Miss
+	r.failures = m.Fail
+	r.passNodes = m.PassNodes
+	r.failNodes = m.FailNodes
+	r.sig, r.sigSet = neofscrypto.Signature{}, false
+
+	return nil
+}
+
+// FromProtoMessage validates m according to the NeoFS API protocol and restores
+// r from it. Any previously attached signature is dropped.
+//
+// See also [Result.ProtoMessage].
+func (r *Result) FromProtoMessage(m *protoaudit.DataAuditResult) error {
+	return r.fromProtoMessage(m, true)
+}
+
+// ProtoMessage converts r into message to transmit using the NeoFS API
+// protocol.
+//
+// See also [Result.FromProtoMessage].
+func (r Result) ProtoMessage() *protoaudit.DataAuditResult {
+	m := &protoaudit.DataAuditResult{
+		AuditEpoch: r.epoch,
+		PublicKey:  r.auditorKey,
+		Complete:   r.completed,
+		Requests:   r.requestsPoR,
+		Retries:    r.retriesPoR,
+		PassSg:     storageGroupsToProto(r.passSG),
+		FailSg:     storageGroupsToProto(r.failSG),
+		Hit:        r.hits,
+		Miss:       r.misses,
+		Fail:       r.failures,
+		PassNodes:  r.passNodes,
+		FailNodes:  r.failNodes,
+	}
+	if r.versionSet {
+		m.Version = r.version.ProtoMessage()
+	}
+	if !r.cnr.IsZero() {
+		m.ContainerId = r.cnr.ProtoMessage()
+	}
+	return m
+}
+
+// Marshal encodes Result into a binary format of the NeoFS API protocol
+// (Protocol Buffers V3 with direct field order). Signature is not encoded.
+//
+// See also [Result.Unmarshal].
+func (r Result) Marshal() []byte {
+	return neofsproto.Marshal(r)
+}
+
+// Unmarshal decodes NeoFS API protocol binary data into the Result
+// (Protocol Buffers V3 with direct field order). Returns an error describing
+// a format violation. Any previously attached signature is dropped.
+//
+// See also [Result.Marshal].
+func (r *Result) Unmarshal(data []byte) error {
+	return neofsproto.UnmarshalOptional(data, r, (*Result).fromProtoMessage)
+}
+
+// MarshalJSON encodes Result into a JSON format of the NeoFS API protocol
+// (Protocol Buffers V3 JSON). Signature is not encoded.
+//
+// See also [Result.UnmarshalJSON].
+func (r Result) MarshalJSON() ([]byte, error) {
+	return neofsproto.MarshalJSON(r)
+}
+
+// UnmarshalJSON decodes NeoFS API protocol JSON data into the Result
+// (Protocol Buffers V3 JSON). Returns an error describing a format violation.
+// Any previously attached signature is dropped.
+//
+// See also [Result.MarshalJSON].
+func (r *Result) UnmarshalJSON(data []byte) error {
+	return neofsproto.UnmarshalJSONOptional(data, r, (*Result).fromProtoMessage)
+}
+
+// SetVersion sets version of the NeoFS API library used to report the Result.
+//
+// SetVersion MUST be called if Result is going to be transmitted over NeoFS
+// API protocol. Usually it is [version.Current].
+//
+// See also [Result.Version].
+func (r *Result) SetVersion(v version.Version) {
+	r.version, r.versionSet = v, true
+}
+
+// Version returns version of the NeoFS API library used to report the Result.
+// Returns false if the version is unset.
+//
+// Zero Result has no version.
+//
+// See also [Result.SetVersion].
+func (r Result) Version() (version.Version, bool) {
+	return r.version, r.versionSet
+}
+
+// SetEpoch sets number of the NeoFS epoch when the data audit was conducted.
+//
+// See also [Result.Epoch].
+func (r *Result) SetEpoch(epoch uint64) {
+	r.epoch = epoch
+}
+
+// Epoch returns number of the NeoFS epoch when the data audit was conducted.
+//
+// Zero Result has zero epoch.
+//
+// See also [Result.SetEpoch].
+func (r Result) Epoch() uint64 {
+	return r.epoch
+}
+
+// SetContainer sets identifier of the container that was under audit.
+//
+// SetContainer MUST be called if Result is going to be transmitted over NeoFS
+// API protocol.
+//
+// See also [Result.Container].
+func (r *Result) SetContainer(cnr cid.ID) {
+	r.cnr = cnr
+}
+
+// Container returns identifier of the container that was under audit.
+//
+// Zero Result has zero container.
+//
+// See also [Result.SetContainer].
+func (r Result) Container() cid.ID {
+	return r.cnr
+}
+
+// SetAuditorKey sets public key of the Inner Ring node that conducted the data
+// audit in a binary format. [Result.Sign] sets it automatically.
+//
+// The argument is not copied, so changing it can lead to data corruption.
+//
+// See also [Result.AuditorKey].
+func (r *Result) SetAuditorKey(key []byte) {
+	r.auditorKey = key
+}
+
+// AuditorKey returns public key of the Inner Ring node that conducted the data
+// audit in a binary format.
+//
+// Zero Result has nil key.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+//
+// See also [Result.SetAuditorKey].
+func (r Result) AuditorKey() []byte {
+	return r.auditorKey
+}
+
+// SetCompleted marks the data audit as completed in time (true) or cancelled
+// (false).
+//
+// See also [Result.Completed].
+func (r *Result) SetCompleted(completed bool) {
+	r.completed = completed
+}
+
+// Completed checks whether the data audit was completed in time.
+//
+// Zero Result is not completed.
+//
+// See also [Result.SetCompleted].
+func (r Result) Completed() bool {
+	return r.completed
+}
+
+// SetRequestsPoR sets number of requests made during the Proof of Retrievability
+// (PoR) stage of the data audit.
+//
+// See also [Result.RequestsPoR].
+func (r *Result) SetRequestsPoR(n uint32) {
+	r.requestsPoR = n
+}
+
+// RequestsPoR returns number of requests made during the Proof of
+// Retrievability (PoR) stage of the data audit.
+//
+// Zero Result has 0 requests.
+//
+// See also [Result.SetRequestsPoR].
+func (r Result) RequestsPoR() uint32 {
+	return r.requestsPoR
+}
+
+// SetRetriesPoR sets number of retries made during the Proof of Retrievability
+// (PoR) stage of the data audit.
+//
+// See also [Result.RetriesPoR].
+func (r *Result) SetRetriesPoR(n uint32) {
+	r.retriesPoR = n
+}
+
+// RetriesPoR returns number of retries made during the Proof of Retrievability
+// (PoR) stage of the data audit.
+//
+// Zero Result has 0 retries.
+//
+// See also [Result.SetRetriesPoR].
+func (r Result) RetriesPoR() uint32 {
+	return r.retriesPoR
+}
+
+// SetPassedStorageGroups sets list of storage groups that passed the Proof of
+// Retrievability (PoR) stage of the data audit.
+//
+// The argument is not copied, so changing it can lead to data corruption.
+//
+// See also [Result.PassedStorageGroups].
+func (r *Result) SetPassedStorageGroups(ids []oid.ID) {
+	r.passSG = ids
+}
+
+// PassedStorageGroups returns list of storage groups that passed the Proof of
+// Retrievability (PoR) stage of the data audit.
+//
+// Zero Result has nil list.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+//
+// See also [Result.SetPassedStorageGroups].
+func (r Result) PassedStorageGroups() []oid.ID {
+	return r.passSG
+}
+
+// SetFailedStorageGroups sets list of storage groups that failed the Proof of
+// Retrievability (PoR) stage of the data audit.
+//
+// The argument is not copied, so changing it can lead to data corruption.
+//
+// See also [Result.FailedStorageGroups].
+func (r *Result) SetFailedStorageGroups(ids []oid.ID) {
+	r.failSG = ids
+}
+
+// FailedStorageGroups returns list of storage groups that failed the Proof of
+// Retrievability (PoR) stage of the data audit.
+//
+// Zero Result has nil list.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+//
+// See also [Result.SetFailedStorageGroups].
+func (r Result) FailedStorageGroups() []oid.ID {
+	return r.failSG
+}
+
+// SetHits sets number of sampled objects placed in an optimal way according
+// to the container's placement policy during the Proof of Placement (PoP)
+// stage of the data audit.
+//
+// See also [Result.Hits].
+func (r *Result) SetHits(n uint32) {
+	r.hits = n
+}
+
+// Hits returns number of sampled objects placed in an optimal way according
+// to the container's placement policy during the Proof of Placement (PoP)
+// stage of the data audit.
+//
+// Zero Result has 0 hits.
+//
+// See also [Result.SetHits].
+func (r Result) Hits() uint32 {
+	return r.hits
+}
+
+// SetMisses sets number of sampled objects placed in a suboptimal but still
+// satisfactory way according to the container's placement policy during the
+// Proof of Placement (PoP) stage of the data audit.
+//
+// See also [Result.Misses].
+func (r *Result) SetMisses(n uint32) {
+	r.misses = n
+}
+
+// Misses returns number of sampled objects placed in a suboptimal but still
+// satisfactory way according to the container's placement policy during the
+// Proof of Placement (PoP) stage of the data audit.
+//
+// Zero Result has 0 misses.
+//
+// See also [Result.SetMisses].
+func (r Result) Misses() uint32 {
+	return r.misses
+}
+
+// SetFailures sets number of sampled objects stored inconsistently with the
+// container's placement policy or not found at all during the Proof of
+// Placement (PoP) stage of the data audit.
+//
+// See also [Result.Failures].
+func (r *Result) SetFailures(n uint32) {
+	r.failures = n
+}
+
+// Failures returns number of sampled objects stored inconsistently with the
+// container's placement policy or not found at all during the Proof of
+// Placement (PoP) stage of the data audit.
+//
+// Zero Result has 0 failures.
+//
+// See also [Result.SetFailures].
+func (r Result) Failures() uint32 {
+	return r.failures
+}
+
+// SetPassedStorageNodes sets binary public keys of the storage nodes that
+// passed at least one Proof of Data Possession (PDP) of the data audit.
+//
+// The argument is not copied, so changing it can lead to data corruption.
+//
+// See also [Result.PassedStorageNodes].
+func (r *Result) SetPassedStorageNodes(keys [][]byte) {
+	r.passNodes = keys
+}
+
+// PassedStorageNodes returns binary public keys of the storage nodes that
+// passed at least one Proof of Data Possession (PDP) of the data audit.
+//
+// Zero Result has nil list.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+//
+// See also [Result.SetPassedStorageNodes].
+func (r Result) PassedStorageNodes() [][]byte {
+	return r.passNodes
+}
+
+// SetFailedStorageNodes sets binary public keys of the storage nodes that
+// failed at least one Proof of Data Possession (PDP) of the data audit.
+//
+// The argument is not copied, so changing it can lead to data corruption.
+//
+// See also [Result.FailedStorageNodes].
+func (r *Result) SetFailedStorageNodes(keys [][]byte) {
+	r.failNodes = keys
+}
+
+// FailedStorageNodes returns binary public keys of the storage nodes that
+// failed at least one Proof of Data Possession (PDP) of the data audit.
+//
+// Zero Result has nil list.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+//
+// See also [Result.SetFailedStorageNodes].
+func (r Result) FailedStorageNodes() [][]byte {
+	return r.failNodes
+}
+
+// Sign calculates and writes signature of the [Result] data along with auditor
+// key using signer. Returns signature calculation errors.
+//
+// Note that any [Result] mutation is likely to break the signature, so it is
+// expected to be calculated as a final stage of Result formation.
+//
+// See also [Result.VerifySignature], [Result.AuditorKey], [Result.SignedData].
+func (r *Result) Sign(signer neofscrypto.Signer) error {
+	r.SetAuditorKey(neofscrypto.PublicKeyBytes(signer.Public()))
+
+	err := r.sig.Calculate(signer, r.SignedData())
+	if err == nil {
+		r.sigSet = true
+	}
+	return err
+}
+
+// SignedData returns actual payload to sign. It is the same as
+// [Result.Marshal] result, so [Result.Unmarshal] is a reverse op.
+//
+// See also [Result.Sign].
+func (r Result) SignedData() []byte {
+	return r.Marshal()
+}
+
+// AttachSignature attaches given signature to the Result. Use
+// [Result.SignedData] for calculation. If signature instance itself is not
+// needed, use [Result.Sign].
+func (r *Result) AttachSignature(sig neofscrypto.Signature) {
+	r.sig, r.sigSet = sig, true
+}
+
+// Signature returns Result signature. If the signature is missing, false is
+// returned. Use [Result.SignedData] for verification. If signature instance
+// itself is not needed, use [Result.VerifySignature].
+func (r Result) Signature() (neofscrypto.Signature, bool) {
+	return r.sig, r.sigSet
+}
+
+// VerifySignature checks if Result signature is presented, valid and made by
+// the auditor, i.e. signature public key equals to [Result.AuditorKey].
+//
+// Zero Result fails the check.
+//
+// See also [Result.Sign].
+func (r Result) VerifySignature() bool {
+	return r.sigSet && bytes.Equal(r.sig.PublicKeyBytes(), r.auditorKey) && r.sig.Verify(r.SignedData())
+}
@@ -0,0 +1,533 @@
+package audit_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/audit"
+	audittest "github.com/nspcc-dev/neofs-sdk-go/audit/test"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	protoaudit "github.com/nspcc-dev/neofs-sdk-go/proto/audit"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	anyValidEpoch    = 4102870918
+	anyValidRequests = 1843729572
+	anyValidRetries  = 902384102
+	anyValidHits     = 3284092
+	anyValidMisses   = 12390
+	anyValidFailures = 843
+)
+
+var (
+	anyValidVersion   = version.New(2, 13)
+	anyValidContainer = cid.ID{245, 188, 86, 80, 170, 97, 147, 48, 75, 27, 115, 238, 61, 151, 182, 191, 95, 33,
+		160, 138, 45, 5, 172, 150, 179, 187, 163, 43, 112, 178, 176, 223}
+	anyValidPassedSG = []oid.ID{{178, 74, 58, 219, 46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44,
+		18, 56, 117, 173, 70, 246, 8, 139, 247, 174, 53, 60}}
+	anyValidFailedSG = []oid.ID{{229, 77, 63, 235, 2, 9, 165, 123, 116, 123, 47, 65, 22, 34, 214, 76, 45, 225, 21, 46,
+		135, 32, 116, 172, 67, 213, 243, 57, 253, 127, 179, 235}}
+	anyValidPassedNodes = [][]byte{[]byte("pass_node_1"), []byte("pass_node_2")}
+	anyValidFailedNodes = [][]byte{[]byte("fail_node_1")}
+)
+
+// Crypto.
+var (
+	anyValidAuditorPrivateKey = ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: elliptic.P256(),
+			X: new(big.Int).SetBytes([]byte{62, 189, 227, 96, 231, 242, 24, 64, 42, 170, 29, 55, 182, 194,
+				249, 108, 30, 148, 108, 174, 30, 231, 53, 68, 115, 29, 241, 13, 51, 25, 155, 43}),
+			Y: new(big.Int).SetBytes([]byte{136, 146, 121, 11, 234, 137, 251, 64, 44, 241, 84, 74, 155, 77, 39,
+				139, 155, 185, 229, 26, 216, 16, 7, 91, 103, 247, 239, 154, 86, 178, 10, 26}),
+		},
+		D: new(big.Int).SetBytes([]byte{163, 20, 59, 38, 227, 11, 133, 215, 52, 179, 128, 186, 160, 119, 108,
+			250, 126, 175, 247, 137, 208, 141, 168, 209, 28, 64, 224, 13, 96, 178, 158, 181}),
+	}
+	anyValidAuditorKey = []byte{2, 62, 189, 227, 96, 231, 242, 24, 64, 42, 170, 29, 55, 182, 194, 249, 108, 30, 148,
+		108, 174, 30, 231, 53, 68, 115, 29, 241, 13, 51, 25, 155, 43}
+	// RFC 6979 signature of validBinResult.
+	anyValidSignatureBytes = []byte{40, 243, 122, 240, 135, 102, 192, 214, 162, 46, 208, 75, 167, 122, 211, 139, 250, 251,
+		112, 66, 3, 61, 160, 187, 154, 36, 112, 175, 31, 149, 156, 195, 50, 18, 235, 109, 242, 170, 50, 111, 215, 139, 227,
+		131, 4, 152, 249, 27, 61, 115, 72, 93, 57, 200, 149, 126, 195, 12, 31, 238, 110, 83, 108, 80}
+	anyValidSignature = neofscrypto.NewSignatureFromRawKey(neofscrypto.ECDSA_DETERMINISTIC_SHA256, anyValidAuditorKey,
+		anyValidSignatureBytes)
+)
+
+// set by init.
+var validResult audit.Result
+
+func init() {
+	validResult.SetVersion(anyValidVersion)
+	validResult.SetEpoch(anyValidEpoch)
+	validResult.SetContainer(anyValidContainer)
+	validResult.SetAuditorKey(anyValidAuditorKey)
+	validResult.SetCompleted(true)
+	validResult.SetRequestsPoR(anyValidRequests)
+	validResult.SetRetriesPoR(anyValidRetries)
+	validResult.SetPassedStorageGroups(anyValidPassedSG)
+	validResult.SetFailedStorageGroups(anyValidFailedSG)
+	validResult.SetHits(anyValidHits)
+	validResult.SetMisses(anyValidMisses)
+	validResult.SetFailures(anyValidFailures)
+	validResult.SetPassedStorageNodes(anyValidPassedNodes)
+	validResult.SetFailedStorageNodes(anyValidFailedNodes)
+}
+
+var validBinResult = []byte{10, 4, 8, 2, 16, 13, 17, 134, 215, 140, 244, 0, 0, 0, 0, 26, 34, 10, 32, 245, 188, 86, 80, 170,
+	97, 147, 48, 75, 27, 115, 238, 61, 151, 182, 191, 95, 33, 160, 138, 45, 5, 172, 150, 179, 187, 163, 43, 112, 178, 176,
+	223, 34, 33, 2, 62, 189, 227, 96, 231, 242, 24, 64, 42, 170, 29, 55, 182, 194, 249, 108, 30, 148, 108, 174, 30, 231, 53,
+	68, 115, 29, 241, 13, 51, 25, 155, 43, 40, 1, 48, 164, 169, 148, 239, 6, 56, 230, 147, 165, 174, 3, 66, 34, 10, 32, 178,
+	74, 58, 219, 46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44, 18, 56, 117, 173, 70, 246, 8, 139, 247,
+	174, 53, 60, 74, 34, 10, 32, 229, 77, 63, 235, 2, 9, 165, 123, 116, 123, 47, 65, 22, 34, 214, 76, 45, 225, 21, 46, 135,
+	32, 116, 172, 67, 213, 243, 57, 253, 127, 179, 235, 80, 252, 184, 200, 1, 88, 230, 96, 96, 203, 6, 106, 11, 112, 97, 115,
+	115, 95, 110, 111, 100, 101, 95, 49, 106, 11, 112, 97, 115, 115, 95, 110, 111, 100, 101, 95, 50, 114, 11, 102, 97, 105,
+	108, 95, 110, 111, 100, 101, 95, 49}
+
+var validJSONResult = `
+{
+ "version": {
+  "major": 2,
+  "minor": 13
+ },
+ "auditEpoch": "4102870918",
+ "containerID": {
+  "value": "9bxWUKphkzBLG3PuPZe2v18hoIotBayWs7ujK3CysN8="
+ },
+ "publicKey": "Aj6942Dn8hhAKqodN7bC+WwelGyuHuc1RHMd8Q0zGZsr",
+ "complete": true,
+ "requests": 1843729572,
+ "retries": 902384102,
+ "passSG": [
+  {
+   "value": "sko62y4Dbn3cUe4jGwbkwb7gTSwSOHWtRvYIi/euNTw="
+  }
+ ],
+ "failSG": [
+  {
+   "value": "5U0/6wIJpXt0ey9BFiLWTC3hFS6HIHSsQ9XzOf1/s+s="
+  }
+ ],
+ "hit": 3284092,
+ "miss": 12390,
+ "fail": 843,
+ "passNodes": [
+  "cGFzc19ub2RlXzE=",
+  "cGFzc19ub2RlXzI="
+ ],
+ "failNodes": [
+  "ZmFpbF9ub2RlXzE="
+ ]
+}
+`
+
+func TestResult_SetVersion(t *testing.T) {
+	var r audit.Result
+	_, ok := r.Version()
+	require.False(t, ok)
+
+	r.SetVersion(anyValidVersion)
+	v, ok := r.Version()
+	require.True(t, ok)
+	require.Equal(t, anyValidVersion, v)
+
+	r.SetVersion(version.Current())
+	v, ok = r.Version()
+	require.True(t, ok)
+	require.Equal(t, version.Current(), v)
+}
+
+func TestResult_SetEpoch(t *testing.T) {
+	var r audit.Result
+	require.Zero(t, r.Epoch())
+
+	val := rand.Uint64()
+	r.SetEpoch(val)
+	require.EqualValues(t, val, r.Epoch())
+	r.SetEpoch(val + 1)
+	require.EqualValues(t, val+1, r.Epoch())
+}
+
+func TestResult_SetContainer(t *testing.T) {
+	var r audit.Result
+	require.Zero(t, r.Container())
+
+	cnr := cidtest.ID()
+	r.SetContainer(cnr)
+	require.Equal(t, cnr, r.Container())
+	cnrOther := cidtest.OtherID(cnr)
+	r.SetContainer(cnrOther)
+	require.Equal(t, cnrOther, r.Container())
+}
+
+func TestResult_SetAuditorKey(t *testing.T) {
+	var r audit.Result
+	require.Zero(t, r.AuditorKey())
+
+	key := neofscryptotest.Signer().PublicKeyBytes
+	r.SetAuditorKey(key)
+	require.Equal(t, key, r.AuditorKey())
+	keyOther := neofscryptotest.Signer().PublicKeyBytes
+	r.SetAuditorKey(keyOther)
+	require.Equal(t, keyOther, r.AuditorKey())
+}
+
+func TestResult_SetCompleted(t *testing.T) {
+	var r audit.Result
+	require.False(t, r.Completed())
+
+	r.SetCompleted(true)
+	require.True(t, r.Completed())
+	r.SetCompleted(false)
+	require.False(t, r.Completed())
+}
+
+func testCounter(t *testing.T, get func(audit.Result) uint32, set func(*audit.Result, uint32)) {
+	var r audit.Result
+	require.Zero(t, get(r))
+
+	val := rand.Uint32()
+	set(&r, val)
+	require.EqualValues(t, val, get(r))
+	set(&r, val+1)
+	require.EqualValues(t, val+1, get(r))
+}
+
+func TestResult_SetRequestsPoR(t *testing.T) {
+	testCounter(t, audit.Result.RequestsPoR, (*audit.Result).SetRequestsPoR)
+}
+
+func TestResult_SetRetriesPoR(t *testing.T) {
+	testCounter(t, audit.Result.RetriesPoR, (*audit.Result).SetRetriesPoR)
+}
+
+func TestResult_SetHits(t *testing.T) {
+	testCounter(t, audit.Result.Hits, (*audit.Result).SetHits)
+}
+
+func TestResult_SetMisses(t *testing.T) {
+	testCounter(t, audit.Result.Misses, (*audit.Result).SetMisses)
+}
+
+func TestResult_SetFailures(t *testing.T) {
+	testCounter(t, audit.Result.Failures, (*audit.Result).SetFailures)
+}
+
+func testStorageGroups(t *testing.T, get func(audit.Result) []oid.ID, set func(*audit.Result, []oid.ID)) {
+	var r audit.Result
+	require.Zero(t, get(r))
+
+	ids := oidtest.IDs(3)
+	set(&r, ids)
+	require.Equal(t, ids, get(r))
+	idsOther := oidtest.IDs(4)
+	set(&r, idsOther)
+	require.Equal(t, idsOther, get(r))
+}
+
+func TestResult_SetPassedStorageGroups(t *testing.T) {
+	testStorageGroups(t, audit.Result.PassedStorageGroups, (*audit.Result).SetPassedStorageGroups)
+}
+
+func TestResult_SetFailedStorageGroups(t *testing.T) {
+	testStorageGroups(t, audit.Result.FailedStorageGroups, (*audit.Result).SetFailedStorageGroups)
+}
+
+func testStorageNodes(t *testing.T, get func(audit.Result) [][]byte, set func(*audit.Result, [][]byte)) {
+	var r audit.Result
+	require.Zero(t, get(r))
+
+	keys := [][]byte{neofscryptotest.Signer().PublicKeyBytes, neofscryptotest.Signer().PublicKeyBytes}
+	set(&r, keys)
+	require.Equal(t, keys, get(r))
+	keysOther := [][]byte{neofscryptotest.Signer().PublicKeyBytes}
+	set(&r, keysOther)
+	require.Equal(t, keysOther, get(r))
+}
+
+func TestResult_SetPassedStorageNodes(t *testing.T) {
+	testStorageNodes(t, audit.Result.PassedStorageNodes, (*audit.Result).SetPassedStorageNodes)
+}
+
+func TestResult_SetFailedStorageNodes(t *testing.T) {
+	testStorageNodes(t, audit.Result.FailedStorageNodes, (*audit.Result).SetFailedStorageNodes)
+}
+
+func TestResult_Sign(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		r := validResult
+		require.Error(t, r.Sign(neofscryptotest.FailSigner(neofscryptotest.Signer())))
+		_, ok := r.Signature()
+		require.False(t, ok)
+	})
+
+	r := validResult
+	r.SetAuditorKey(nil)
+	require.NoError(t, r.Sign(neofsecdsa.SignerRFC6979(anyValidAuditorPrivateKey)))
+	require.Equal(t, anyValidAuditorKey, r.AuditorKey())
+	sig, ok := r.Signature()
+	require.True(t, ok)
+	require.Equal(t, anyValidSignature, sig)
+	require.True(t, r.VerifySignature())
+
+	for _, signer := range []neofscrypto.Signer{
+		neofsecdsa.Signer(anyValidAuditorPrivateKey),
+		neofsecdsa.SignerWalletConnect(anyValidAuditorPrivateKey),
+	} {
+		r := validResult
+		require.NoError(t, r.Sign(signer), signer.Scheme())
+		sig, ok := r.Signature()
+		require.True(t, ok)
+		require.Equal(t, signer.Scheme(), sig.Scheme())
+		require.Equal(t, anyValidAuditorKey, sig.PublicKeyBytes())
+		require.True(t, r.VerifySignature(), signer.Scheme())
+	}
+}
+
+func TestResult_SignedData(t *testing.T) {
+	require.Equal(t, validBinResult, validResult.SignedData())
+}
+
+func TestResult_VerifySignature(t *testing.T) {
+	var r audit.Result
+	require.False(t, r.VerifySignature())
+
+	r = validResult
+	require.False(t, r.VerifySignature())
+	r.AttachSignature(anyValidSignature)
+	require.True(t, r.VerifySignature())
+
+	t.Run("corrupted signature", func(t *testing.T) {
+		for k := range anyValidSignatureBytes {
+			sigBytesCp := bytes.Clone(anyValidSignatureBytes)
+			sigBytesCp[k]++
+			r := validResult
+			r.AttachSignature(neofscrypto.NewSignatureFromRawKey(neofscrypto.ECDSA_DETERMINISTIC_SHA256, anyValidAuditorKey, sigBytesCp))
+			require.False(t, r.VerifySignature(), k)
+		}
+	})
+	t.Run("changed data", func(t *testing.T) {
+		r := validResult
+		r.SetEpoch(anyValidEpoch + 1)
+		r.AttachSignature(anyValidSignature)
+		require.False(t, r.VerifySignature())
+	})
+	t.Run("not by auditor", func(t *testing.T) {
+		r := validResult
+		signer := neofscryptotest.Signer()
+		require.NoError(t, r.Sign(signer))
+		require.True(t, r.VerifySignature())
+		r.SetAuditorKey(anyValidAuditorKey)
+		sig, _ := r.Signature()
+		require.NoError(t, sig.Calculate(signer, r.SignedData()))
+		r.AttachSignature(sig)
+		require.False(t, r.VerifySignature())
+	})
+}
+
+func TestResult_AttachSignature(t *testing.T) {
+	var r audit.Result
+	_, ok := r.Signature()
+	require.False(t, ok)
+	r.AttachSignature(anyValidSignature)
+	sig, ok := r.Signature()
+	require.True(t, ok)
+	require.Equal(t, anyValidSignature, sig)
+}
+
+func TestResult_FromProtoMessage(t *testing.T) {
+	m := &protoaudit.DataAuditResult{
+		Version:     &refs.Version{Major: 2, Minor: 13},
+		AuditEpoch:  anyValidEpoch,
+		ContainerId: &refs.ContainerID{Value: anyValidContainer[:]},
+		PublicKey:   anyValidAuditorKey,
+		Complete:    true,
+		Requests:    anyValidRequests,
+		Retries:     anyValidRetries,
+		PassSg:      []*refs.ObjectID{{Value: anyValidPassedSG[0][:]}},
+		FailSg:      []*refs.ObjectID{{Value: anyValidFailedSG[0][:]}},
+		Hit:         anyValidHits,
+		Miss:        anyValidMisses,
+		Fail:        anyValidFailures,
+		PassNodes:   anyValidPassedNodes,
+		FailNodes:   anyValidFailedNodes,
+	}
+
+	var r audit.Result
+	r.AttachSignature(anyValidSignature)
+	require.NoError(t, r.FromProtoMessage(m))
+	require.Equal(t, validResult, r)
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name, err string
+			corrupt   func(*protoaudit.DataAuditResult)
+		}{
+			{name: "version/missing", err: "missing version",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.Version = nil }},
+			{name: "container/missing", err: "missing container",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.ContainerId = nil }},
+			{name: "container/nil value", err: "invalid container: invalid length 0",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.ContainerId = new(refs.ContainerID) }},
+			{name: "container/wrong length", err: "invalid container: invalid length 31",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.ContainerId.Value = make([]byte, 31) }},
+			{name: "container/zero", err: "invalid container: zero container ID",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.ContainerId.Value = make([]byte, 32) }},
+			{name: "passed storage groups/nil element", err: "invalid passed storage groups: nil element #1",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.PassSg = append(m.PassSg, nil) }},
+			{name: "passed storage groups/wrong length", err: "invalid passed storage groups: invalid element #0: invalid length 31",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.PassSg[0].Value = make([]byte, 31) }},
+			{name: "passed storage groups/zero", err: "invalid passed storage groups: invalid element #0: zero object ID",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.PassSg[0].Value = make([]byte, 32) }},
+			{name: "failed storage groups/nil element", err: "invalid failed storage groups: nil element #1",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.FailSg = append(m.FailSg, nil) }},
+			{name: "failed storage groups/wrong length", err: "invalid failed storage groups: invalid element #0: invalid length 33",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.FailSg[0].Value = make([]byte, 33) }},
+			{name: "failed storage groups/zero", err: "invalid failed storage groups: invalid element #0: zero object ID",
+				corrupt: func(m *protoaudit.DataAuditResult) { m.FailSg[0].Value = make([]byte, 32) }},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				m := validResult.ProtoMessage()
+				tc.corrupt(m)
+				require.EqualError(t, new(audit.Result).FromProtoMessage(m), tc.err)
+			})
+		}
+	})
+}
+
+func TestResult_ProtoMessage(t *testing.T) {
+	var r audit.Result
+
+	// zero
+	m := r.ProtoMessage()
+	require.Zero(t, m.GetVersion())
+	require.Zero(t, m.GetAuditEpoch())
+	require.Zero(t, m.GetContainerId())
+	require.Zero(t, m.GetPublicKey())
+	require.Zero(t, m.GetComplete())
+	require.Zero(t, m.GetRequests())
+	require.Zero(t, m.GetRetries())
+	require.Zero(t, m.GetPassSg())
+	require.Zero(t, m.GetFailSg())
+	require.Zero(t, m.GetHit())
+	require.Zero(t, m.GetMiss())
+	require.Zero(t, m.GetFail())
+	require.Zero(t, m.GetPassNodes())
+	require.Zero(t, m.GetFailNodes())
+
+	// filled
+	m = validResult.ProtoMessage()
+	require.EqualValues(t, 2, m.GetVersion().GetMajor())
+	require.EqualValues(t, 13, m.GetVersion().GetMinor())
+	require.EqualValues(t, anyValidEpoch, m.GetAuditEpoch())
+	require.Equal(t, anyValidContainer[:], m.GetContainerId().GetValue())
+	require.Equal(t, anyValidAuditorKey, m.GetPublicKey())
+	require.True(t, m.GetComplete())
+	require.EqualValues(t, anyValidRequests, m.GetRequests())
+	require.EqualValues(t, anyValidRetries, m.GetRetries())
+	require.Len(t, m.GetPassSg(), 1)
+	require.Equal(t, anyValidPassedSG[0][:], m.GetPassSg()[0].GetValue())
+	require.Len(t, m.GetFailSg(), 1)
+	require.Equal(t, anyValidFailedSG[0][:], m.GetFailSg()[0].GetValue())
+	require.EqualValues(t, anyValidHits, m.GetHit())
+	require.EqualValues(t, anyValidMisses, m.GetMiss())
+	require.EqualValues(t, anyValidFailures, m.GetFail())
+	require.Equal(t, anyValidPassedNodes, m.GetPassNodes())
+	require.Equal(t, anyValidFailedNodes, m.GetFailNodes())
+}
+
+func TestResult_Marshal(t *testing.T) {
+	require.Equal(t, validBinResult, validResult.Marshal())
+}
+
+func TestResult_Unmarshal(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("protobuf", func(t *testing.T) {
+			err := new(audit.Result).Unmarshal([]byte("Hello, world!"))
+			require.ErrorContains(t, err, "proto")
+			require.ErrorContains(t, err, "cannot parse invalid wire-format data")
+		})
+		for _, tc := range []struct {
+			name, err string
+			b         []byte
+		}{
+			{name: "container/empty value", err: "invalid container: invalid length 0", b: []byte{26, 0}},
+			{name: "passed storage groups/empty value", err: "invalid passed storage groups: invalid element #0: invalid length 0",
+				b: []byte{66, 0}},
+			{name: "failed storage groups/empty value", err: "invalid failed storage groups: invalid element #0: invalid length 0",
+				b: []byte{74, 0}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				require.EqualError(t, new(audit.Result).Unmarshal(tc.b), tc.err)
+			})
+		}
+	})
+
+	var r audit.Result
+	// zero
+	require.NoError(t, r.Unmarshal(nil))
+	require.Zero(t, r)
+
+	// filled
+	r.AttachSignature(anyValidSignature)
+	require.NoError(t, r.Unmarshal(validBinResult))
+	require.Equal(t, validResult, r)
+
+	// random
+	rnd := audittest.Result()
+	require.NoError(t, r.Unmarshal(rnd.Marshal()))
+	sig, _ := rnd.Signature()
+	r.AttachSignature(sig)
+	require.Equal(t, rnd, r)
+	require.True(t, r.VerifySignature())
+}
+
+func TestResult_MarshalJSON(t *testing.T) {
+	b, err := validResult.MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, validJSONResult, string(b))
+}
+
+func TestResult_UnmarshalJSON(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("protobuf", func(t *testing.T) {
+			err := new(audit.Result).UnmarshalJSON([]byte("Hello, world!"))
+			require.ErrorContains(t, err, "proto")
+			require.ErrorContains(t, err, "syntax error")
+		})
+		t.Run("container", func(t *testing.T) {
+			err := new(audit.Result).UnmarshalJSON([]byte(`{"containerID":{"value":"AA=="}}`))
+			require.EqualError(t, err, "invalid container: invalid length 1")
+		})
+	})
+
+	var r audit.Result
+	// zero
+	require.NoError(t, r.UnmarshalJSON([]byte("{}")))
+	require.Zero(t, r)
+
+	// filled
+	require.NoError(t, r.UnmarshalJSON([]byte(validJSONResult)))
+	require.Equal(t, validResult, r)
+
+	// random
+	rnd := audittest.Result()
+	b, err := rnd.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, r.UnmarshalJSON(b))
+	sig, _ := rnd.Signature()
+	r.AttachSignature(sig)
+	require.Equal(t, rnd, r)
+}
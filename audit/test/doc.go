@@ -0,0 +1,13 @@
+/*
+Package audittest provides functions for convenient testing of audit package API.
+
+Note that importing the package into source files is highly discouraged.
+
+Random instance generation functions can be useful when testing expects any value, e.g.:
+
+	import audittest "github.com/nspcc-dev/neofs-sdk-go/audit/test"
+
+	res := audittest.Result()
+	// test the value
+*/
+package audittest
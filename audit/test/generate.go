@@ -0,0 +1,43 @@
+package audittest
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/nspcc-dev/neofs-sdk-go/audit"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+func nodeKeys(n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = neofscryptotest.Signer().PublicKeyBytes
+	}
+	return res
+}
+
+// Result returns random signed audit.Result.
+func Result() audit.Result {
+	var r audit.Result
+	r.SetVersion(version.New(rand.Uint32(), rand.Uint32()))
+	r.SetEpoch(rand.Uint64())
+	r.SetContainer(cidtest.ID())
+	r.SetCompleted(rand.Int()%2 == 0)
+	r.SetRequestsPoR(rand.Uint32())
+	r.SetRetriesPoR(rand.Uint32())
+	r.SetPassedStorageGroups(oidtest.IDs(1 + rand.IntN(3)))
+	r.SetFailedStorageGroups(oidtest.IDs(1 + rand.IntN(3)))
+	r.SetHits(rand.Uint32())
+	r.SetMisses(rand.Uint32())
+	r.SetFailures(rand.Uint32())
+	r.SetPassedStorageNodes(nodeKeys(1 + rand.IntN(3)))
+	r.SetFailedStorageNodes(nodeKeys(1 + rand.IntN(3)))
+	if err := r.Sign(neofscryptotest.Signer()); err != nil {
+		panic(fmt.Errorf("unexpected sign error: %w", err))
+	}
+
+	return r
+}
@@ -0,0 +1,27 @@
+package audittest_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/audit"
+	audittest "github.com/nspcc-dev/neofs-sdk-go/audit/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResult(t *testing.T) {
+	r := audittest.Result()
+	require.NotEqual(t, r, audittest.Result())
+	require.True(t, r.VerifySignature())
+
+	m := r.ProtoMessage()
+	var r2 audit.Result
+	require.NoError(t, r2.FromProtoMessage(m))
+	sig, _ := r.Signature()
+	r2.AttachSignature(sig)
+	require.Equal(t, r, r2)
+
+	var r3 audit.Result
+	require.NoError(t, r3.Unmarshal(r.Marshal()))
+	r3.AttachSignature(sig)
+	require.Equal(t, r, r3)
+}
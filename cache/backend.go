@@ -0,0 +1,55 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Backend is a storage of the cached values. Backend must be safe for
+// concurrent use. Backend may drop any stored entries at any time.
+type Backend interface {
+	// Get returns value stored under the given key. Returns false if there is
+	// no such entry. Caller does not modify the returned value.
+	Get(key string) ([]byte, bool)
+	// Add stores value under the given key overwriting any previous one.
+	// Backend owns the value after the call.
+	Add(key string, value []byte)
+	// Remove deletes value stored under the given key if any.
+	Remove(key string)
+}
+
+// LRU is an in-memory [Backend] of limited size evicting least recently used
+// entries.
+//
+// LRU should be created using [NewLRU].
+type LRU struct {
+	cache *lru.Cache[string, []byte]
+}
+
+// NewLRU constructs new LRU holding up to size entries. Size must be positive.
+func NewLRU(size int) (*LRU, error) {
+	c, err := lru.New[string, []byte](size)
+	if err != nil {
+		return nil, err
+	}
+	return &LRU{cache: c}, nil
+}
+
+// Get implements [Backend].
+func (x *LRU) Get(key string) ([]byte, bool) {
+	return x.cache.Get(key)
+}
+
+// Add implements [Backend].
+func (x *LRU) Add(key string, value []byte) {
+	x.cache.Add(key, value)
+}
+
+// Remove implements [Backend].
+func (x *LRU) Remove(key string) {
+	x.cache.Remove(key)
+}
+
+// Len returns number of stored entries.
+func (x *LRU) Len() int {
+	return x.cache.Len()
+}
@@ -0,0 +1,222 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+const defaultEpochCheckInterval = time.Minute
+
+// Executor describes NeoFS API client methods decorated by [Cache]. Both
+// [client.Client] and [pool.Pool] implement it.
+type Executor interface {
+	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*object.Object, error)
+	ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error)
+	ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error)
+	NetMapSnapshot(ctx context.Context, prm client.PrmNetMapSnapshot) (netmap.NetMap, error)
+	NetworkInfo(ctx context.Context, prm client.PrmNetworkInfo) (netmap.NetworkInfo, error)
+}
+
+// Options groups optional [Cache] parameters. Zero Options are valid.
+type Options struct {
+	epochCheckInterval time.Duration
+	statCallback       stat.CacheCallback
+}
+
+// SetEpochCheckInterval sets how often [Cache] requests current epoch from the
+// network. Zero means 1 minute. Negative value disables requests, in this case
+// the epoch must be set using [Cache.SetEpoch]. Until the next check, entries
+// of the previous epoch may still be served.
+func (x *Options) SetEpochCheckInterval(d time.Duration) {
+	x.epochCheckInterval = d
+}
+
+// SetStatisticCallback sets callback receiving cache hits and misses, e.g.
+// [stat.CacheStat.CacheCallback].
+func (x *Options) SetStatisticCallback(f stat.CacheCallback) {
+	x.statCallback = f
+}
+
+// Cache decorates [Executor] serving repeated requests from the [Backend]. Only
+// successful results are cached. Object headers are immutable, so they are
+// cached until evicted by the [Backend]. Containers, eACL tables and network
+// maps are cached within the current epoch only.
+//
+// Unless stated otherwise for particular method, Cache shares results between
+// all request parameters including signers and tokens, so it must not be used
+// when access to the data differs for them.
+// Backend must not be shared between caches over different networks.
+//
+// Cache should be created using [New].
+type Cache struct {
+	executor           Executor
+	backend            Backend
+	epochCheckInterval time.Duration
+	statCallback       stat.CacheCallback
+
+	mtx       sync.Mutex
+	epoch     uint64
+	epochTime time.Time
+}
+
+// New constructs new Cache over the given executor storing values in the
+// provided backend.
+func New(e Executor, b Backend, opts Options) *Cache {
+	if opts.epochCheckInterval == 0 {
+		opts.epochCheckInterval = defaultEpochCheckInterval
+	}
+
+	return &Cache{
+		executor:           e,
+		backend:            b,
+		epochCheckInterval: opts.epochCheckInterval,
+		statCallback:       opts.statCallback,
+	}
+}
+
+// SetEpoch sets current NeoFS epoch. Entries cached within previous epochs are
+// no longer served. SetEpoch is useful when application tracks epochs itself,
+// e.g. via chain notifications. Epochs less than the current one are ignored.
+func (c *Cache) SetEpoch(epoch uint64) {
+	c.mtx.Lock()
+	c.setEpoch(epoch)
+	c.mtx.Unlock()
+}
+
+func (c *Cache) setEpoch(epoch uint64) {
+	if c.epochTime.IsZero() || epoch >= c.epoch {
+		c.epoch = epoch
+	}
+	c.epochTime = time.Now()
+}
+
+// currentEpoch returns current NeoFS epoch refreshing it if needed. Returns
+// false if epoch is unknown.
+func (c *Cache) currentEpoch(ctx context.Context) (uint64, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	known := !c.epochTime.IsZero()
+	if c.epochCheckInterval < 0 || known && time.Since(c.epochTime) < c.epochCheckInterval {
+		return c.epoch, known
+	}
+
+	ni, err := c.executor.NetworkInfo(ctx, client.PrmNetworkInfo{})
+	if err != nil {
+		// outdated epoch is still better than nothing
+		return c.epoch, known
+	}
+
+	c.setEpoch(ni.CurrentEpoch())
+
+	return c.epoch, true
+}
+
+// epochKey returns backend key of the entry cached within the current epoch.
+// Returns empty key if epoch is unknown.
+func (c *Cache) epochKey(ctx context.Context, prefix string, id string) string {
+	epoch, ok := c.currentEpoch(ctx)
+	if !ok {
+		return ""
+	}
+	return prefix + "/" + strconv.FormatUint(epoch, 10) + "/" + id
+}
+
+func (c *Cache) report(method stat.Method, hit bool) {
+	if c.statCallback != nil {
+		c.statCallback(method, hit)
+	}
+}
+
+// get returns value stored in the backend by the given key or fetches and
+// stores it. Empty key means the value must not be cached.
+func get[T any](c *Cache, method stat.Method, key string, fetch func() (T, error), encode func(T) []byte, decode func([]byte) (T, error)) (T, error) {
+	if key != "" {
+		if b, ok := c.backend.Get(key); ok {
+			v, err := decode(b)
+			if err == nil {
+				c.report(method, true)
+				return v, nil
+			}
+			c.backend.Remove(key)
+		}
+	}
+
+	c.report(method, false)
+
+	v, err := fetch()
+	if err == nil && key != "" {
+		c.backend.Add(key, encode(v))
+	}
+
+	return v, err
+}
+
+// ObjectHead works like [client.Client.ObjectHead] caching object headers
+// forever. Headers requested with [client.PrmObjectHead.MarkRaw] and
+// [client.PrmObjectHead.MarkLocal] are cached separately.
+func (c *Cache) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*object.Object, error) {
+	key := "object/" + containerID.EncodeToString() + "/" + objectID.EncodeToString()
+	if prm.IsRaw() {
+		key += "/raw"
+	}
+	if prm.IsLocal() {
+		key += "/local"
+	}
+	return get(c, stat.MethodObjectHead, key, func() (*object.Object, error) {
+		return c.executor.ObjectHead(ctx, containerID, objectID, signer, prm)
+	}, func(hdr *object.Object) []byte {
+		return hdr.Marshal()
+	}, func(b []byte) (*object.Object, error) {
+		var hdr object.Object
+		return &hdr, hdr.Unmarshal(b)
+	})
+}
+
+// ContainerGet works like [client.Client.ContainerGet] caching containers
+// within the current epoch.
+func (c *Cache) ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error) {
+	return get(c, stat.MethodContainerGet, c.epochKey(ctx, "container", id.EncodeToString()), func() (container.Container, error) {
+		return c.executor.ContainerGet(ctx, id, prm)
+	}, container.Container.Marshal, func(b []byte) (container.Container, error) {
+		var cnr container.Container
+		return cnr, cnr.Unmarshal(b)
+	})
+}
+
+// ContainerEACL works like [client.Client.ContainerEACL] caching eACL tables
+// within the current epoch.
+func (c *Cache) ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error) {
+	return get(c, stat.MethodContainerEACL, c.epochKey(ctx, "eacl", id.EncodeToString()), func() (eacl.Table, error) {
+		return c.executor.ContainerEACL(ctx, id, prm)
+	}, eacl.Table.Marshal, func(b []byte) (eacl.Table, error) {
+		var table eacl.Table
+		return table, table.Unmarshal(b)
+	})
+}
+
+// NetMapSnapshot works like [client.Client.NetMapSnapshot] caching network map
+// within the current epoch.
+func (c *Cache) NetMapSnapshot(ctx context.Context, prm client.PrmNetMapSnapshot) (netmap.NetMap, error) {
+	return get(c, stat.MethodNetMapSnapshot, c.epochKey(ctx, "netmap", ""), func() (netmap.NetMap, error) {
+		return c.executor.NetMapSnapshot(ctx, prm)
+	}, func(nm netmap.NetMap) []byte {
+		return neofsproto.Marshal(nm)
+	}, func(b []byte) (netmap.NetMap, error) {
+		var nm netmap.NetMap
+		return nm, neofsproto.Unmarshal(b, &nm)
+	})
+}
@@ -0,0 +1,281 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/cache"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	eacltest "github.com/nspcc-dev/neofs-sdk-go/eacl/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ cache.Executor = (*client.Client)(nil)
+	_ cache.Executor = (*pool.Pool)(nil)
+)
+
+type executor struct {
+	epoch atomic.Uint64
+	err   error
+
+	header    object.Object
+	container container.Container
+	eACL      eacl.Table
+	netMap    netmap.NetMap
+
+	calls [stat.MethodLast]atomic.Int32
+}
+
+func newExecutor() *executor {
+	var nm netmap.NetMap
+	nm.SetEpoch(10)
+	nm.SetNodes([]netmap.NodeInfo{netmaptest.NodeInfo(), netmaptest.NodeInfo()})
+
+	e := &executor{
+		header:    objecttest.Object(),
+		container: containertest.Container(),
+		eACL:      eacltest.Table(),
+		netMap:    nm,
+	}
+	e.epoch.Store(10)
+	return e
+}
+
+func (e *executor) ObjectHead(_ context.Context, _ cid.ID, _ oid.ID, _ user.Signer, _ client.PrmObjectHead) (*object.Object, error) {
+	e.calls[stat.MethodObjectHead].Add(1)
+	if e.err != nil {
+		return nil, e.err
+	}
+	var hdr object.Object
+	e.header.CopyTo(&hdr)
+	return &hdr, nil
+}
+
+func (e *executor) ContainerGet(context.Context, cid.ID, client.PrmContainerGet) (container.Container, error) {
+	e.calls[stat.MethodContainerGet].Add(1)
+	return e.container, e.err
+}
+
+func (e *executor) ContainerEACL(context.Context, cid.ID, client.PrmContainerEACL) (eacl.Table, error) {
+	e.calls[stat.MethodContainerEACL].Add(1)
+	return e.eACL, e.err
+}
+
+func (e *executor) NetMapSnapshot(context.Context, client.PrmNetMapSnapshot) (netmap.NetMap, error) {
+	e.calls[stat.MethodNetMapSnapshot].Add(1)
+	return e.netMap, e.err
+}
+
+func (e *executor) NetworkInfo(context.Context, client.PrmNetworkInfo) (netmap.NetworkInfo, error) {
+	e.calls[stat.MethodNetworkInfo].Add(1)
+	var ni netmap.NetworkInfo
+	ni.SetCurrentEpoch(e.epoch.Load())
+	return ni, nil
+}
+
+func newCache(t testing.TB, e cache.Executor, opts cache.Options) (*cache.Cache, *cache.LRU, *stat.CacheStat) {
+	b, err := cache.NewLRU(100)
+	require.NoError(t, err)
+	st := stat.NewCacheStatistic()
+	opts.SetStatisticCallback(st.CacheCallback)
+	return cache.New(e, b, opts), b, st
+}
+
+func TestCache_ObjectHead(t *testing.T) {
+	e := newExecutor()
+	c, b, st := newCache(t, e, cache.Options{})
+	cnr, id := cidtest.ID(), oidtest.ID()
+
+	for range 3 {
+		hdr, err := c.ObjectHead(t.Context(), cnr, id, usertest.User(), client.PrmObjectHead{})
+		require.NoError(t, err)
+		require.Equal(t, e.header.Marshal(), hdr.Marshal())
+	}
+	require.EqualValues(t, 1, e.calls[stat.MethodObjectHead].Load())
+	require.EqualValues(t, 2, st.Hits(stat.MethodObjectHead))
+	require.EqualValues(t, 1, st.Misses(stat.MethodObjectHead))
+	require.Zero(t, e.calls[stat.MethodNetworkInfo].Load())
+
+	t.Run("modification", func(t *testing.T) {
+		hdr, err := c.ObjectHead(t.Context(), cnr, id, usertest.User(), client.PrmObjectHead{})
+		require.NoError(t, err)
+		hdr.SetOwner(usertest.ID())
+
+		hdr2, err := c.ObjectHead(t.Context(), cnr, id, usertest.User(), client.PrmObjectHead{})
+		require.NoError(t, err)
+		require.Equal(t, e.header.Marshal(), hdr2.Marshal())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		e.err = apistatus.ErrObjectNotFound
+		t.Cleanup(func() { e.err = nil })
+		other := oidtest.ID()
+
+		for range 2 {
+			_, err := c.ObjectHead(t.Context(), cnr, other, usertest.User(), client.PrmObjectHead{})
+			require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+		}
+		require.EqualValues(t, 3, e.calls[stat.MethodObjectHead].Load())
+	})
+
+	t.Run("corrupted entry", func(t *testing.T) {
+		other := oidtest.ID()
+		_, err := c.ObjectHead(t.Context(), cnr, other, usertest.User(), client.PrmObjectHead{})
+		require.NoError(t, err)
+		b.Add("object/"+cnr.EncodeToString()+"/"+other.EncodeToString(), []byte("definitely not an object"))
+
+		calls := e.calls[stat.MethodObjectHead].Load()
+		hdr, err := c.ObjectHead(t.Context(), cnr, other, usertest.User(), client.PrmObjectHead{})
+		require.NoError(t, err)
+		require.Equal(t, e.header.Marshal(), hdr.Marshal())
+		require.Equal(t, calls+1, e.calls[stat.MethodObjectHead].Load())
+	})
+
+	t.Run("parameters", func(t *testing.T) {
+		other := oidtest.ID()
+		var raw, local, rawLocal client.PrmObjectHead
+		raw.MarkRaw()
+		local.MarkLocal()
+		rawLocal.MarkRaw()
+		rawLocal.MarkLocal()
+
+		calls := e.calls[stat.MethodObjectHead].Load()
+		for range 2 {
+			for _, prm := range []client.PrmObjectHead{{}, raw, local, rawLocal} {
+				_, err := c.ObjectHead(t.Context(), cnr, other, usertest.User(), prm)
+				require.NoError(t, err)
+			}
+		}
+		require.Equal(t, calls+4, e.calls[stat.MethodObjectHead].Load())
+	})
+}
+
+func TestCache_Epoch(t *testing.T) {
+	cnr := cidtest.ID()
+
+	check := func(t *testing.T, c *cache.Cache, e *executor, expCalls int32) {
+		res, err := c.ContainerGet(t.Context(), cnr, client.PrmContainerGet{})
+		require.NoError(t, err)
+		require.Equal(t, e.container.Marshal(), res.Marshal())
+
+		table, err := c.ContainerEACL(t.Context(), cnr, client.PrmContainerEACL{})
+		require.NoError(t, err)
+		require.Equal(t, e.eACL.Marshal(), table.Marshal())
+
+		nm, err := c.NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+		require.NoError(t, err)
+		require.Equal(t, e.netMap.Epoch(), nm.Epoch())
+		require.Len(t, nm.Nodes(), len(e.netMap.Nodes()))
+		for i, n := range nm.Nodes() {
+			require.Equal(t, e.netMap.Nodes()[i].PublicKey(), n.PublicKey())
+		}
+
+		for _, m := range []stat.Method{stat.MethodContainerGet, stat.MethodContainerEACL, stat.MethodNetMapSnapshot} {
+			require.Equal(t, expCalls, e.calls[m].Load(), m)
+		}
+	}
+
+	t.Run("network", func(t *testing.T) {
+		e := newExecutor()
+		var opts cache.Options
+		opts.SetEpochCheckInterval(10 * time.Millisecond)
+		c, _, st := newCache(t, e, opts)
+
+		check(t, c, e, 1)
+		check(t, c, e, 1)
+		require.EqualValues(t, 1, st.Hits(stat.MethodContainerGet))
+		require.EqualValues(t, 1, st.Misses(stat.MethodContainerGet))
+
+		// epoch is not checked too often
+		e.epoch.Store(11)
+		check(t, c, e, 1)
+
+		time.Sleep(20 * time.Millisecond)
+		check(t, c, e, 2)
+		check(t, c, e, 2)
+		require.EqualValues(t, 2, e.calls[stat.MethodNetworkInfo].Load())
+	})
+
+	t.Run("manual", func(t *testing.T) {
+		e := newExecutor()
+		var opts cache.Options
+		opts.SetEpochCheckInterval(-1)
+		c, _, st := newCache(t, e, opts)
+
+		// epoch is unknown, nothing is cached
+		check(t, c, e, 1)
+		check(t, c, e, 2)
+		require.Zero(t, st.Hits(stat.MethodContainerGet))
+
+		c.SetEpoch(10)
+		check(t, c, e, 3)
+		check(t, c, e, 3)
+
+		c.SetEpoch(11)
+		check(t, c, e, 4)
+		check(t, c, e, 4)
+
+		// previous epochs are ignored
+		c.SetEpoch(10)
+		check(t, c, e, 4)
+		require.Zero(t, e.calls[stat.MethodNetworkInfo].Load())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		e := newExecutor()
+		c, _, _ := newCache(t, e, cache.Options{})
+		e.err = errors.New("any error")
+
+		for range 2 {
+			_, err := c.ContainerGet(t.Context(), cnr, client.PrmContainerGet{})
+			require.ErrorIs(t, err, e.err)
+		}
+		require.EqualValues(t, 2, e.calls[stat.MethodContainerGet].Load())
+	})
+}
+
+func TestNewLRU(t *testing.T) {
+	_, err := cache.NewLRU(0)
+	require.EqualError(t, err, "must provide a positive size")
+
+	b, err := cache.NewLRU(2)
+	require.NoError(t, err)
+
+	b.Add("a", []byte{1})
+	b.Add("b", []byte{2})
+	_, ok := b.Get("a")
+	require.True(t, ok)
+	b.Add("c", []byte{3})
+	require.Equal(t, 2, b.Len())
+
+	_, ok = b.Get("b")
+	require.False(t, ok)
+	v, ok := b.Get("a")
+	require.True(t, ok)
+	require.Equal(t, []byte{1}, v)
+
+	b.Remove("a")
+	_, ok = b.Get("a")
+	require.False(t, ok)
+	require.Equal(t, 1, b.Len())
+}
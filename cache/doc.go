@@ -0,0 +1,14 @@
+/*
+Package cache provides caching decorator for read operations of NeoFS API
+clients such as [client.Client] and [pool.Pool].
+
+[Cache] serves repeated requests from the [Backend] storage instead of the
+network. Caching rules depend on mutability of the requested data: object
+headers never change and are cached until evicted, while containers, eACL
+tables and network maps are cached within the current NeoFS epoch only.
+
+[LRU] is an in-memory [Backend] evicting least recently used entries. Other
+storages, e.g. shared between several processes, can be plugged in by
+implementing [Backend] interface.
+*/
+package cache
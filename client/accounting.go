@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	protoaccounting "github.com/nspcc-dev/neofs-sdk-go/proto/accounting"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
 	"github.com/nspcc-dev/neofs-sdk-go/stat"
@@ -45,6 +46,10 @@ func (c *Client) BalanceGet(ctx context.Context, prm PrmBalanceGet) (accounting.
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodBalanceGet)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	switch {
 	case prm.account.IsZero():
 		err = ErrMissingAccount
@@ -11,6 +11,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/internal/uriutil"
 	protoaccounting "github.com/nspcc-dev/neofs-sdk-go/proto/accounting"
 	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
@@ -21,6 +22,7 @@ import (
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
 	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -76,6 +78,8 @@ type Client struct {
 	endpoint string
 	nodeKey  []byte
 
+	tracer telemetry.Tracer
+
 	apiVersion *protorefs.Version
 
 	buffers *sync.Pool
@@ -109,6 +113,7 @@ func New(prm PrmInit) (*Client, error) {
 	}
 
 	c.prm = prm
+	c.tracer = telemetry.NewTracer(prm.tracerProvider, "github.com/nspcc-dev/neofs-sdk-go/client", "client.", trace.SpanKindClient)
 	return c, nil
 }
 
@@ -204,13 +209,20 @@ func (c *Client) Dial(prm PrmDial) error {
 	// TODO: copy-pasted from neofs-api-go. Replace deprecated func with
 	//  grpc.NewClient. This was not done because some options are no longer
 	//  supported. Review carefully and make a proper transition.
-	//nolint:staticcheck
-	conn, err := grpc.DialContext(ctx, addr,
+	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
 		grpc.WithReturnConnectionError(),
 		grpc.FailOnNonTempDialError(true),
 		grpc.WithContextDialer(prm.customConnFunc),
-	)
+	}
+	if prm.faultInjector != nil {
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(prm.faultInjector.unaryInterceptor),
+			grpc.WithChainStreamInterceptor(prm.faultInjector.streamInterceptor),
+		)
+	}
+	//nolint:staticcheck
+	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return fmt.Errorf("gRPC dial: %w", err)
 	}
@@ -276,6 +288,8 @@ type PrmInit struct {
 	netMagic uint64 //nolint:unused // https://github.com/nspcc-dev/neofs-sdk-go/issues/671
 
 	statisticCallback stat.OperationCallback
+	payloadCallback   stat.PayloadCallback
+	tracerProvider    trace.TracerProvider
 
 	signMessageBufferSizes uint64
 	buffers                *sync.Pool
@@ -297,6 +311,34 @@ func (x *PrmInit) SetStatisticCallback(statisticCallback stat.OperationCallback)
 	x.statisticCallback = statisticCallback
 }
 
+// SetPayloadCallback makes the Client to pass [stat.PayloadCallback] for the
+// external statistic of the object payload bytes transmitted by
+// [Client.ObjectGetInit], [Client.ObjectRangeInit] and [Client.ObjectPutInit]
+// streams.
+func (x *PrmInit) SetPayloadCallback(payloadCallback stat.PayloadCallback) {
+	x.payloadCallback = payloadCallback
+}
+
+// SetTracerProvider makes the Client to trace its operations via OpenTelemetry
+// spans created by the given provider. Span is created for each operation and
+// each object stream, span names are [stat.Method] strings prefixed with
+// "client.". Spans have neofs.node.endpoint attribute, as well as
+// neofs.container.id, neofs.object.id and neofs.payload.size ones where
+// applicable. Finished spans get neofs.status.code attribute with the NeoFS
+// API status code unless the operation failed without a response.
+//
+// Trace context is propagated to the server via request X-headers named
+// according to W3C Trace Context specification: traceparent and tracestate.
+// Requests of [Client.SetContainerAttribute] and
+// [Client.RemoveContainerAttribute] have no meta header, so trace context is
+// not propagated for them.
+//
+// Tracing is disabled by default. Use [stat.NewOTelStatistic] to export
+// operation metrics.
+func (x *PrmInit) SetTracerProvider(tp trace.TracerProvider) {
+	x.tracerProvider = tp
+}
+
 type connFunc = func(ctx context.Context, addr string) (net.Conn, error)
 
 // PrmDial groups connection parameters for the Client.
@@ -316,6 +358,8 @@ type PrmDial struct {
 	parentCtx context.Context
 
 	customConnFunc connFunc
+
+	faultInjector *FaultInjector
 }
 
 // SetServerURI sets server URI in the NeoFS network.
@@ -365,14 +409,22 @@ func (x *PrmDial) SetContext(ctx context.Context) {
 	x.parentCtx = ctx
 }
 
-// allows to override default gRPC dialer for testing. The func must not be nil.
-func (x *PrmDial) setDialFunc(connFunc connFunc) {
+// SetDialFunc overrides default network dialer used to connect to the server,
+// e.g. to connect to the in-memory listener in tests. The func is called with
+// the address from the server URI. The func must not be nil.
+func (x *PrmDial) SetDialFunc(connFunc func(ctx context.Context, addr string) (net.Conn, error)) {
 	if connFunc == nil {
 		panic("nil func does not override the default")
 	}
 	x.customConnFunc = connFunc
 }
 
+// SetFaultInjector makes the Client to break RPCs deliberately according to
+// the given [FaultInjector]. Intended for testing only.
+func (x *PrmDial) SetFaultInjector(f *FaultInjector) {
+	x.faultInjector = f
+}
+
 // NewGRPC constructs Client from the provided gRPC connection with options.
 // Resulting client is ready for RPCs, [Client.Dial] must not be called for it.
 // All requests, except ops accepting signer parameter, are signed with
@@ -179,7 +179,7 @@ loop:
 
 	var dialPrm PrmDial
 	dialPrm.SetServerURI(testServerEndpoint)
-	dialPrm.setDialFunc(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) })
+	dialPrm.SetDialFunc(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) })
 	err = c.Dial(dialPrm)
 	require.NoError(t, err)
 
@@ -12,6 +12,7 @@ import (
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
 	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
 	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
@@ -124,6 +125,10 @@ func (c *Client) ContainerPut(ctx context.Context, cont container.Container, sig
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerPut)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return cid.ID{}, ErrMissingSigner
 	}
@@ -253,6 +258,10 @@ func (c *Client) ContainerGet(ctx context.Context, id cid.ID, prm PrmContainerGe
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerGet, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	req := &protocontainer.GetRequest{
 		Body: &protocontainer.GetRequest_Body{
 			ContainerId: id.ProtoMessage(),
@@ -319,6 +328,10 @@ func (c *Client) ContainerList(ctx context.Context, ownerID user.ID, prm PrmCont
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerList)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	req := &protocontainer.ListRequest{
 		Body: &protocontainer.ListRequest_Body{
 			OwnerId: ownerID.ProtoMessage(),
@@ -443,6 +456,10 @@ func (c *Client) ContainerDelete(ctx context.Context, id cid.ID, signer neofscry
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerDelete, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return ErrMissingSigner
 	}
@@ -522,6 +539,10 @@ func (c *Client) ContainerEACL(ctx context.Context, id cid.ID, prm PrmContainerE
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerEACL, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	req := &protocontainer.GetExtendedACLRequest{
 		Body: &protocontainer.GetExtendedACLRequest_Body{
 			ContainerId: id.ProtoMessage(),
@@ -650,6 +671,10 @@ func (c *Client) ContainerSetEACL(ctx context.Context, table eacl.Table, signer
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerSetEACL, telemetry.ContainerID(table.GetCID()))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return ErrMissingSigner
 	}
@@ -714,6 +739,76 @@ func (c *Client) ContainerSetEACL(ctx context.Context, table eacl.Table, signer
 	return err
 }
 
+// PrmAnnounceSpace groups optional parameters of ContainerAnnounceUsedSpace operation.
+type PrmAnnounceSpace struct {
+	prmCommonMeta
+}
+
+// ContainerAnnounceUsedSpace sends request to announce volume of the space used for the container objects.
+//
+// Any errors (local or remote, including returned status codes) are returned as Go errors,
+// see [apistatus] package for NeoFS-specific error types.
+//
+// Operation is asynchronous and no guarantees are provided regarding the state
+// of the announced values after the call returns successfully.
+//
+// Context is required and must not be nil. It is used for network communication.
+//
+// Return errors:
+//   - [ErrMissingAnnouncements]
+//
+// Parameter announcements must not be empty.
+func (c *Client) ContainerAnnounceUsedSpace(ctx context.Context, announcements []container.SizeEstimation, prm PrmAnnounceSpace) error {
+	var err error
+	if c.prm.statisticCallback != nil {
+		startTime := time.Now()
+		defer func() {
+			c.sendStatistic(stat.MethodContainerAnnounceUsedSpace, time.Since(startTime), err)
+		}()
+	}
+
+	ctx, span := c.startSpan(ctx, stat.MethodContainerAnnounceUsedSpace)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
+	if len(announcements) == 0 {
+		err = ErrMissingAnnouncements
+		return err
+	}
+
+	req := &protocontainer.AnnounceUsedSpaceRequest{
+		Body: &protocontainer.AnnounceUsedSpaceRequest_Body{
+			Announcements: make([]*protocontainer.AnnounceUsedSpaceRequest_Body_Announcement, len(announcements)),
+		},
+		MetaHeader: &protosession.RequestMetaHeader{
+			Version: c.apiVersion,
+			Ttl:     defaultRequestTTL,
+		},
+	}
+	for i := range announcements {
+		req.Body.Announcements[i] = announcements[i].ProtoMessage()
+	}
+	writeXHeadersToMeta(prm.xHeaders, req.MetaHeader)
+
+	buf := c.buffers.Get().(*[]byte)
+	defer func() { c.buffers.Put(buf) }()
+
+	req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer[*protocontainer.AnnounceUsedSpaceRequest_Body](c.prm.signer, req, *buf)
+	if err != nil {
+		err = fmt.Errorf("%w: %w", errSignRequest, err)
+		return err
+	}
+
+	resp, err := c.container.AnnounceUsedSpace(ctx, req)
+	if err != nil {
+		err = rpcErr(err)
+		return err
+	}
+
+	err = apistatus.ToError(resp.GetMetaHeader().GetStatus())
+	return err
+}
+
 // SyncContainerWithNetwork requests network configuration using passed [NetworkInfoExecutor]
 // and applies/rewrites it to the container.
 //
@@ -834,6 +929,10 @@ func (c *Client) SetContainerAttribute(ctx context.Context, prm SetContainerAttr
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerSetAttribute, telemetry.ContainerID(prm.ID))
+	defer func() { telemetry.End(span, err) }()
+	// request has no meta header, so trace context is not propagated
+
 	req := &protocontainer.SetAttributeRequest{
 		Body: &protocontainer.SetAttributeRequest_Body{
 			Parameters: &protocontainer.SetAttributeRequest_Body_Parameters{
@@ -982,6 +1081,10 @@ func (c *Client) RemoveContainerAttribute(ctx context.Context, prm RemoveContain
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodContainerRemoveAttribute, telemetry.ContainerID(prm.ID))
+	defer func() { telemetry.End(span, err) }()
+	// request has no meta header, so trace context is not propagated
+
 	req := &protocontainer.RemoveAttributeRequest{
 		Body: &protocontainer.RemoveAttributeRequest_Body{
 			Parameters: &protocontainer.RemoveAttributeRequest_Body_Parameters{
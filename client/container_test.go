@@ -2305,3 +2305,194 @@ func TestClient_RemoveContainerAttribute(t *testing.T) {
 		)
 	})
 }
+
+type testAnnounceUsedSpaceServer struct {
+	protocontainer.UnimplementedContainerServiceServer
+	testCommonUnaryServerSettings[
+		*protocontainer.AnnounceUsedSpaceRequest_Body,
+		*protocontainer.AnnounceUsedSpaceRequest,
+		*protocontainer.AnnounceUsedSpaceResponse_Body,
+		*protocontainer.AnnounceUsedSpaceResponse,
+	]
+	reqAnnouncements []container.SizeEstimation
+}
+
+// returns [protocontainer.ContainerServiceServer] supporting AnnounceUsedSpace
+// method only. Default implementation performs common verification of any
+// request, and responds with any valid message. Some methods allow to tune the
+// behavior.
+func newTestAnnounceUsedSpaceServer() *testAnnounceUsedSpaceServer {
+	return new(testAnnounceUsedSpaceServer)
+}
+
+// makes the server to assert that any request has given announcements. By
+// default, and if nil, any valid announcements are accepted.
+func (x *testAnnounceUsedSpaceServer) checkRequestAnnouncements(es []container.SizeEstimation) {
+	x.reqAnnouncements = es
+}
+
+func (x *testAnnounceUsedSpaceServer) verifyRequest(req *protocontainer.AnnounceUsedSpaceRequest) error {
+	if err := x.testCommonUnaryServerSettings.verifyRequest(req); err != nil {
+		return err
+	}
+	// meta header
+	switch metaHdr := req.MetaHeader; {
+	case metaHdr.Ttl != 2:
+		return newInvalidRequestMetaHeaderErr(fmt.Errorf("wrong TTL %d, expected 2", metaHdr.Ttl))
+	case metaHdr.SessionToken != nil:
+		return newInvalidRequestMetaHeaderErr(errors.New("session token attached while should not be"))
+	case metaHdr.BearerToken != nil:
+		return newInvalidRequestMetaHeaderErr(errors.New("bearer token attached while should not be"))
+	}
+	// body
+	body := req.Body
+	if body == nil {
+		return newInvalidRequestBodyErr(errors.New("missing body"))
+	}
+	// 1. announcements
+	if len(body.Announcements) == 0 {
+		return newErrMissingRequestBodyField("announcements")
+	}
+	if x.reqAnnouncements != nil && len(body.Announcements) != len(x.reqAnnouncements) {
+		return newErrInvalidRequestField("announcements", fmt.Errorf("number of elements (client: %d, message: %d)",
+			len(x.reqAnnouncements), len(body.Announcements)))
+	}
+	for i := range body.Announcements {
+		var e container.SizeEstimation
+		if err := e.FromProtoMessage(body.Announcements[i]); err != nil {
+			return newErrInvalidRequestField("announcements", fmt.Errorf("element #%d: %w", i, err))
+		}
+		if x.reqAnnouncements != nil && e != x.reqAnnouncements[i] {
+			return newErrInvalidRequestField("announcements", fmt.Errorf("element #%d mismatches the test input", i))
+		}
+	}
+	return nil
+}
+
+func (x *testAnnounceUsedSpaceServer) AnnounceUsedSpace(_ context.Context, req *protocontainer.AnnounceUsedSpaceRequest,
+) (*protocontainer.AnnounceUsedSpaceResponse, error) {
+	time.Sleep(x.handlerSleepDur)
+	if err := x.verifyRequest(req); err != nil {
+		return nil, err
+	}
+	if x.handlerErr != nil {
+		return nil, x.handlerErr
+	}
+
+	resp := &protocontainer.AnnounceUsedSpaceResponse{
+		MetaHeader: x.respMeta,
+	}
+	if x.respBodyForced {
+		resp.Body = x.respBody
+	} else {
+		resp.Body = proto.Clone(validMinAnnounceUsedSpaceResponseBody).(*protocontainer.AnnounceUsedSpaceResponse_Body)
+	}
+
+	var err error
+	resp.VerifyHeader, err = x.signResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("sign response: %w", err)
+	}
+	return resp, nil
+}
+
+func TestClient_ContainerAnnounceUsedSpace(t *testing.T) {
+	ctx := context.Background()
+	var anyValidOpts PrmAnnounceSpace
+	anyValidAnnouncements := []container.SizeEstimation{containertest.SizeEstimation(), containertest.SizeEstimation()}
+
+	t.Run("messages", func(t *testing.T) {
+		/*
+			This test is dedicated for cases when user input results in sending a certain
+			request to the server and receiving a specific response to it. For user input
+			errors, transport, client internals, etc. see/add other tests.
+		*/
+		t.Run("requests", func(t *testing.T) {
+			t.Run("required data", func(t *testing.T) {
+				srv := newTestAnnounceUsedSpaceServer()
+				c := newTestContainerClient(t, srv)
+
+				srv.checkRequestAnnouncements(anyValidAnnouncements)
+				srv.authenticateRequest(c.prm.signer)
+				err := c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+				require.NoError(t, err)
+			})
+			t.Run("options", func(t *testing.T) {
+				t.Run("X-headers", func(t *testing.T) {
+					testRequestXHeaders(t, newTestAnnounceUsedSpaceServer, newTestContainerClient, func(c *Client, xhs []string) error {
+						opts := anyValidOpts
+						opts.WithXHeaders(xhs...)
+						return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, opts)
+					})
+				})
+			})
+		})
+		t.Run("responses", func(t *testing.T) {
+			t.Run("valid", func(t *testing.T) {
+				t.Run("payloads", func(t *testing.T) {
+					for _, tc := range []struct {
+						name string
+						body *protocontainer.AnnounceUsedSpaceResponse_Body
+					}{
+						{name: "min", body: validMinAnnounceUsedSpaceResponseBody},
+						{name: "full", body: validFullAnnounceUsedSpaceResponseBody},
+					} {
+						t.Run(tc.name, func(t *testing.T) {
+							srv := newTestAnnounceUsedSpaceServer()
+							c := newTestContainerClient(t, srv)
+
+							srv.respondWithBody(tc.body)
+							err := c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+							require.NoError(t, err)
+						})
+					}
+				})
+				t.Run("statuses", func(t *testing.T) {
+					testStatusResponses(t, newTestAnnounceUsedSpaceServer, newTestContainerClient, func(c *Client) error {
+						return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+					})
+				})
+			})
+			t.Run("invalid", func(t *testing.T) {
+				t.Run("format", func(t *testing.T) {
+					testIncorrectUnaryRPCResponseFormat(t, "container.ContainerService", "AnnounceUsedSpace", func(c *Client) error {
+						return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+					})
+				})
+			})
+		})
+	})
+	t.Run("invalid user input", func(t *testing.T) {
+		c := newClient(t)
+		t.Run("missing announcements", func(t *testing.T) {
+			err := c.ContainerAnnounceUsedSpace(ctx, nil, anyValidOpts)
+			require.ErrorIs(t, err, ErrMissingAnnouncements)
+			err = c.ContainerAnnounceUsedSpace(ctx, []container.SizeEstimation{}, anyValidOpts)
+			require.ErrorIs(t, err, ErrMissingAnnouncements)
+		})
+	})
+	t.Run("context", func(t *testing.T) {
+		testContextErrors(t, newTestAnnounceUsedSpaceServer, newTestContainerClient, func(ctx context.Context, c *Client) error {
+			return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+		})
+	})
+	t.Run("sign request failure", func(t *testing.T) {
+		testSignRequestFailure(t, func(c *Client) error {
+			return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+		})
+	})
+	t.Run("transport failure", func(t *testing.T) {
+		testTransportFailure(t, newTestAnnounceUsedSpaceServer, newTestContainerClient, func(c *Client) error {
+			return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+		})
+	})
+	t.Run("exec statistics", func(t *testing.T) {
+		testStatistic(t, newTestAnnounceUsedSpaceServer, newDefaultContainerService, stat.MethodContainerAnnounceUsedSpace,
+			nil, []testedClientOp{func(c *Client) error {
+				return c.ContainerAnnounceUsedSpace(ctx, nil, anyValidOpts)
+			}}, func(c *Client) error {
+				return c.ContainerAnnounceUsedSpace(ctx, anyValidAnnouncements, anyValidOpts)
+			},
+		)
+	})
+}
@@ -0,0 +1,358 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	protostatus "github.com/nspcc-dev/neofs-sdk-go/proto/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Field numbers common to the NeoFS API responses.
+const (
+	fieldResponseMetaHeader   = 2
+	fieldResponseVerifyHeader = 3
+	fieldVerifyHeaderMetaSig  = 2
+	fieldSignatureValue       = 2
+)
+
+// ErrStreamDropped is returned from the streaming RPCs dropped by the
+// [FaultInjector].
+var ErrStreamDropped = status.Error(codes.Unavailable, "stream dropped by fault injector")
+
+// ErrInvalidResponseSignature is returned from the RPCs of the Client with
+// [FaultInjector] when the response signature is damaged by
+// [FaultInjector.CorruptSignatures].
+var ErrInvalidResponseSignature = errors.New("invalid response signature")
+
+// FaultInjector deliberately breaks the Client RPCs to test retries and error
+// handling of the applications. Faults are set up by FaultInjector methods,
+// each fault is applied to the RPC with the specified probability. Decisions
+// are made by a pseudo-random generator initialized with the seed, so the
+// sequence of injected faults is reproducible as long as the RPCs are executed
+// in the same order.
+//
+// RPCs are selected by full gRPC method names like
+// [protoobject.ObjectService_Get_FullMethodName]. If no methods are specified,
+// the fault is applied to all RPCs.
+//
+// FaultInjector is passed to the Client via [PrmDial.SetFaultInjector]. It must
+// be configured before the Client is dialed. FaultInjector is safe for
+// concurrent use by several Client instances.
+//
+// FaultInjector should be created using [NewFaultInjector].
+type FaultInjector struct {
+	mtx  sync.Mutex
+	rand *rand.Rand
+
+	rules []faultRule
+}
+
+type faultRule struct {
+	probability float64
+	methods     []string
+
+	latency    time.Duration
+	status     *protostatus.Status
+	drop       bool
+	dropAfter  int
+	corruptSig bool
+}
+
+// faults are faults selected for the particular RPC.
+type faults struct {
+	latency    time.Duration
+	status     *protostatus.Status
+	dropAfter  int // negative if stream is not dropped
+	corruptSig bool
+}
+
+// NewFaultInjector constructs new FaultInjector making decisions according to
+// the given seed.
+func NewFaultInjector(seed uint64) *FaultInjector {
+	return &FaultInjector{rand: rand.New(rand.NewPCG(seed, seed))}
+}
+
+func (x *FaultInjector) add(r faultRule, probability float64, methods []string) {
+	if probability < 0 || probability > 1 {
+		panic("probability out of [0, 1] range")
+	}
+	r.probability = probability
+	r.methods = slices.Clone(methods)
+	x.rules = append(x.rules, r)
+}
+
+// InjectLatency delays the RPCs by d. For streaming RPCs, the delay is made
+// before the stream is opened. Delay is interrupted by the RPC context.
+func (x *FaultInjector) InjectLatency(d time.Duration, probability float64, methods ...string) {
+	x.add(faultRule{latency: d}, probability, methods)
+}
+
+// InjectStatus replaces responses with the status corresponding to the given
+// error, see [apistatus.FromError]. The request is still sent to the server,
+// so the operation may be executed there, only the response is lost. For
+// server-streaming RPCs, the first response is replaced. The status is
+// returned by the Client as if it was received from the server, e.g.
+// [apistatus.ErrObjectNotFound] can be checked using [errors.Is].
+func (x *FaultInjector) InjectStatus(err error, probability float64, methods ...string) {
+	if err == nil {
+		panic("nil error")
+	}
+	x.add(faultRule{status: apistatus.FromError(err)}, probability, methods)
+}
+
+// DropStreams breaks streaming RPCs after the given number of messages:
+// received ones for server-streaming RPCs like object GET, sent ones for
+// client-streaming RPCs like object PUT. Next message transmission fails with
+// [ErrStreamDropped]. Unary RPCs are not affected.
+func (x *FaultInjector) DropStreams(afterMessages int, probability float64, methods ...string) {
+	if afterMessages < 0 {
+		panic("negative number of messages")
+	}
+	x.add(faultRule{drop: true, dropAfter: afterMessages}, probability, methods)
+}
+
+// CorruptSignatures damages meta signature in the verification header of the
+// responses. The Client does not verify response signatures itself, so
+// corrupted responses are reported as [ErrInvalidResponseSignature] right
+// away. Responses without verification header are not affected.
+func (x *FaultInjector) CorruptSignatures(probability float64, methods ...string) {
+	x.add(faultRule{corruptSig: true}, probability, methods)
+}
+
+// pick selects faults for the RPC of the given method.
+func (x *FaultInjector) pick(method string) faults {
+	res := faults{dropAfter: -1}
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	for _, r := range x.rules {
+		if len(r.methods) > 0 && !slices.Contains(r.methods, method) {
+			continue
+		}
+		if x.rand.Float64() >= r.probability {
+			continue
+		}
+		res.latency += r.latency
+		if r.status != nil {
+			res.status = r.status
+		}
+		if r.drop && (res.dropAfter < 0 || r.dropAfter < res.dropAfter) {
+			res.dropAfter = r.dropAfter
+		}
+		res.corruptSig = res.corruptSig || r.corruptSig
+	}
+	return res
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// apply applies response faults to the message received from the server.
+func (f faults) apply(msg any) error {
+	if f.status != nil {
+		setResponseStatus(msg, f.status)
+		return nil
+	}
+	if f.corruptSig && corruptResponseSignature(msg) {
+		return ErrInvalidResponseSignature
+	}
+	return nil
+}
+
+func (x *FaultInjector) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	f := x.pick(method)
+	if err := sleepContext(ctx, f.latency); err != nil {
+		return err
+	}
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	return f.apply(reply)
+}
+
+func (x *FaultInjector) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	f := x.pick(method)
+	if err := sleepContext(ctx, f.latency); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if !desc.ServerStreams && !desc.ClientStreams {
+		f.dropAfter = -1
+	}
+	return &faultyStream{ClientStream: stream, cancel: cancel, desc: desc, faults: f}, nil
+}
+
+// faultyStream is a grpc.ClientStream with injected faults.
+type faultyStream struct {
+	grpc.ClientStream
+	cancel context.CancelFunc
+	desc   *grpc.StreamDesc
+	faults faults
+
+	sent, received int
+	statusSent     bool
+}
+
+func (x *faultyStream) drop() error {
+	x.cancel()
+	return ErrStreamDropped
+}
+
+func (x *faultyStream) SendMsg(m any) error {
+	if x.desc.ClientStreams && x.faults.dropAfter >= 0 && x.sent >= x.faults.dropAfter {
+		return x.drop()
+	}
+	if err := x.ClientStream.SendMsg(m); err != nil {
+		return err
+	}
+	x.sent++
+	return nil
+}
+
+func (x *faultyStream) RecvMsg(m any) error {
+	if x.desc.ServerStreams && x.faults.dropAfter >= 0 && x.received >= x.faults.dropAfter {
+		return x.drop()
+	}
+	if x.faults.status != nil {
+		if x.statusSent {
+			return io.EOF
+		}
+		err := x.ClientStream.RecvMsg(m)
+		if err != nil && !errors.Is(err, io.EOF) {
+			x.cancel()
+			return err
+		}
+		x.cancel()
+		x.statusSent = true
+		setResponseStatus(m, x.faults.status)
+		return nil
+	}
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		x.cancel()
+		return err
+	}
+	x.received++
+	if err := x.faults.apply(m); err != nil {
+		x.cancel()
+		return err
+	}
+	return nil
+}
+
+// setResponseStatus replaces the response message with a response carrying
+// only the given status.
+func setResponseStatus(msg any, st *protostatus.Status) {
+	switch m := msg.(type) {
+	case *mem.BufferSlice:
+		mh := neofsproto.MarshalMessage(&protosession.ResponseMetaHeader{Status: st})
+		b := protowire.AppendTag(nil, fieldResponseMetaHeader, protowire.BytesType)
+		b = protowire.AppendBytes(b, mh)
+		m.Free()
+		*m = mem.BufferSlice{mem.SliceBuffer(b)}
+	case proto.Message:
+		proto.Reset(m)
+		r := m.ProtoReflect()
+		fs := r.Descriptor().Fields()
+		if fd := fs.ByName("meta_header"); fd != nil {
+			mh := &protosession.ResponseMetaHeader{Status: st}
+			r.Set(fd, protoreflect.ValueOfMessage(mh.ProtoReflect()))
+		} else if fd = fs.ByName("status"); fd != nil {
+			r.Set(fd, protoreflect.ValueOfMessage(proto.Clone(st).ProtoReflect()))
+		}
+	}
+}
+
+// corruptResponseSignature damages meta signature of the response
+// verification header. Returns false if there is no signature.
+func corruptResponseSignature(msg any) bool {
+	switch m := msg.(type) {
+	case *mem.BufferSlice:
+		b := m.Materialize()
+		if !corruptRawSignature(b) {
+			return false
+		}
+		m.Free()
+		*m = mem.BufferSlice{mem.SliceBuffer(b)}
+		return true
+	case interface {
+		GetVerifyHeader() *protosession.ResponseVerificationHeader
+	}:
+		sig := m.GetVerifyHeader().GetMetaSignature()
+		if len(sig.GetSign()) == 0 {
+			return false
+		}
+		sig.Sign[0] ^= 0xFF
+		return true
+	}
+	return false
+}
+
+// corruptRawSignature corrupts meta signature of the encoded response in
+// place. Returns false if there is no signature.
+func corruptRawSignature(b []byte) bool {
+	b = rawField(b, fieldResponseVerifyHeader)
+	b = rawField(b, fieldVerifyHeaderMetaSig)
+	b = rawField(b, fieldSignatureValue)
+	if len(b) == 0 {
+		return false
+	}
+	b[0] ^= 0xFF
+	return true
+}
+
+// rawField returns value of the length-delimited field with the given number
+// from the encoded message. The value shares memory with b. Returns nil if
+// there is no such field.
+func rawField(b []byte, num protowire.Number) []byte {
+	for len(b) > 0 {
+		n, typ, ln := protowire.ConsumeTag(b)
+		if ln < 0 {
+			return nil
+		}
+		b = b[ln:]
+		if n == num && typ == protowire.BytesType {
+			v, ln := protowire.ConsumeBytes(b)
+			if ln < 0 {
+				return nil
+			}
+			return v
+		}
+		ln = protowire.ConsumeFieldValue(n, typ, b)
+		if ln < 0 {
+			return nil
+		}
+		b = b[ln:]
+	}
+	return nil
+}
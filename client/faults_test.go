@@ -0,0 +1,236 @@
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoaccounting "github.com/nspcc-dev/neofs-sdk-go/proto/accounting"
+	protonetmap "github.com/nspcc-dev/neofs-sdk-go/proto/netmap"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/protobuf/proto"
+)
+
+func newFaultyClient(t *testing.T, f *FaultInjector) (*neofstest.Node, *Client) {
+	node := neofstest.NewNode(neofscryptotest.Signer())
+	t.Cleanup(node.Stop)
+
+	var prm PrmDial
+	prm.SetServerURI("grpc://localhost:8080")
+	prm.SetDialFunc(node.Listen())
+	prm.SetFaultInjector(f)
+
+	c, err := New(PrmInit{})
+	require.NoError(t, err)
+	require.NoError(t, c.Dial(prm))
+	t.Cleanup(func() { _ = c.Close() })
+	return node, c
+}
+
+func putTestObject(t *testing.T, node *neofstest.Node, usr usertest.UserSigner, payload []byte) oid.Address {
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 1"))
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(usr.ID)
+	cnr.SetBasicACL(acl.PublicRW)
+	cnr.SetPlacementPolicy(policy)
+
+	var obj object.Object
+	obj.SetContainerID(node.PutContainer(cnr))
+	obj.SetOwner(usr.ID)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayload(payload)
+	require.NoError(t, obj.SetVerificationFields(usr))
+	node.PutObject(obj)
+	return obj.Address()
+}
+
+func TestFaultInjector_InjectStatus(t *testing.T) {
+	f := NewFaultInjector(0)
+	f.InjectStatus(apistatus.ErrNodeUnderMaintenance, 1,
+		protoaccounting.AccountingService_Balance_FullMethodName,
+		protoobject.ObjectService_Head_FullMethodName,
+		protoobject.ObjectService_Get_FullMethodName,
+		protoobject.ObjectService_Put_FullMethodName,
+	)
+	node, c := newFaultyClient(t, f)
+	usr := usertest.User()
+	addr := putTestObject(t, node, usr, []byte("Hello, world!"))
+
+	var prmBalance PrmBalanceGet
+	prmBalance.SetAccount(usr.ID)
+	_, err := c.BalanceGet(t.Context(), prmBalance)
+	require.ErrorIs(t, err, apistatus.ErrNodeUnderMaintenance)
+
+	_, err = c.ObjectHead(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectHead{})
+	require.ErrorIs(t, err, apistatus.ErrNodeUnderMaintenance)
+
+	_, _, err = c.ObjectGetInit(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectGet{})
+	require.ErrorIs(t, err, apistatus.ErrNodeUnderMaintenance)
+
+	var obj object.Object
+	obj.SetContainerID(addr.Container())
+	obj.SetOwner(usr.ID)
+	w, err := c.ObjectPutInit(t.Context(), obj, usr, PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write([]byte("payload"))
+	require.NoError(t, err)
+	require.ErrorIs(t, w.Close(), apistatus.ErrNodeUnderMaintenance)
+
+	_, err = c.NetworkInfo(t.Context(), PrmNetworkInfo{})
+	require.NoError(t, err)
+}
+
+func TestFaultInjector_DropStreams(t *testing.T) {
+	f := NewFaultInjector(0)
+	f.DropStreams(2, 1, protoobject.ObjectService_Get_FullMethodName)
+	f.DropStreams(1, 1, protoobject.ObjectService_Put_FullMethodName)
+	node, c := newFaultyClient(t, f)
+	usr := usertest.User()
+	payload := make([]byte, 1<<20)
+	addr := putTestObject(t, node, usr, payload)
+
+	_, r, err := c.ObjectGetInit(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectGet{})
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	require.ErrorIs(t, err, ErrStreamDropped)
+
+	_, err = c.ObjectHead(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectHead{})
+	require.NoError(t, err)
+
+	var obj object.Object
+	obj.SetContainerID(addr.Container())
+	obj.SetOwner(usr.ID)
+	w, err := c.ObjectPutInit(t.Context(), obj, usr, PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write(payload)
+	if err == nil {
+		err = w.Close()
+	}
+	require.ErrorIs(t, err, ErrStreamDropped)
+}
+
+func TestFaultInjector_InjectLatency(t *testing.T) {
+	const latency = 50 * time.Millisecond
+	f := NewFaultInjector(0)
+	f.InjectLatency(latency, 1, protonetmap.NetmapService_NetworkInfo_FullMethodName, protoobject.ObjectService_Head_FullMethodName)
+	node, c := newFaultyClient(t, f)
+	usr := usertest.User()
+	addr := putTestObject(t, node, usr, []byte("Hello, world!"))
+
+	start := time.Now()
+	_, err := c.NetworkInfo(t.Context(), PrmNetworkInfo{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), latency)
+
+	start = time.Now()
+	_, err = c.ObjectHead(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectHead{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), latency)
+
+	ctx, cancel := context.WithTimeout(t.Context(), latency/5)
+	defer cancel()
+	_, err = c.NetworkInfo(ctx, PrmNetworkInfo{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFaultInjector_CorruptSignatures(t *testing.T) {
+	f := NewFaultInjector(0)
+	f.CorruptSignatures(1,
+		protonetmap.NetmapService_NetworkInfo_FullMethodName,
+		protoobject.ObjectService_Head_FullMethodName,
+		protoobject.ObjectService_Get_FullMethodName,
+		protoobject.ObjectService_Put_FullMethodName,
+	)
+	node, c := newFaultyClient(t, f)
+	usr := usertest.User()
+	addr := putTestObject(t, node, usr, []byte("Hello, world!"))
+
+	_, err := c.NetworkInfo(t.Context(), PrmNetworkInfo{})
+	require.ErrorIs(t, err, ErrInvalidResponseSignature)
+
+	_, err = c.ObjectHead(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectHead{})
+	require.ErrorIs(t, err, ErrInvalidResponseSignature)
+
+	_, _, err = c.ObjectGetInit(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectGet{})
+	require.ErrorIs(t, err, ErrInvalidResponseSignature)
+
+	var obj object.Object
+	obj.SetContainerID(addr.Container())
+	obj.SetOwner(usr.ID)
+	w, err := c.ObjectPutInit(t.Context(), obj, usr, PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write([]byte("payload"))
+	require.NoError(t, err)
+	require.ErrorIs(t, w.Close(), ErrInvalidResponseSignature)
+
+	var prmBalance PrmBalanceGet
+	prmBalance.SetAccount(usr.ID)
+	_, err = c.BalanceGet(t.Context(), prmBalance)
+	require.NoError(t, err)
+
+	t.Run("raw", func(t *testing.T) {
+		resp := &protonetmap.NetworkInfoResponse{
+			Body:       new(protonetmap.NetworkInfoResponse_Body),
+			MetaHeader: &protosession.ResponseMetaHeader{Epoch: 42},
+		}
+		resp.VerifyHeader, err = neofscrypto.SignResponseWithBuffer(neofscryptotest.Signer(), resp, nil)
+		require.NoError(t, err)
+		b, err := proto.Marshal(resp)
+		require.NoError(t, err)
+
+		bs := mem.BufferSlice{mem.SliceBuffer(b)}
+		require.True(t, corruptResponseSignature(&bs))
+
+		var res protonetmap.NetworkInfoResponse
+		require.NoError(t, proto.Unmarshal(bs.Materialize(), &res))
+		require.EqualValues(t, 42, res.MetaHeader.Epoch)
+		require.Error(t, neofscrypto.VerifyResponseWithBuffer(&res, nil))
+	})
+}
+
+func TestFaultInjector_Seed(t *testing.T) {
+	decisions := func(seed uint64) []bool {
+		f := NewFaultInjector(seed)
+		f.InjectStatus(apistatus.ErrServerInternal, 0.5)
+		res := make([]bool, 100)
+		for i := range res {
+			res[i] = f.pick(protoobject.ObjectService_Head_FullMethodName).status != nil
+		}
+		return res
+	}
+
+	res := decisions(42)
+	require.Contains(t, res, true)
+	require.Contains(t, res, false)
+	require.Equal(t, res, decisions(42))
+	require.NotEqual(t, res, decisions(43))
+}
+
+func TestFaultInjector_Methods(t *testing.T) {
+	f := NewFaultInjector(0)
+	f.InjectStatus(apistatus.ErrServerInternal, 1, protoobject.ObjectService_Head_FullMethodName)
+	f.InjectLatency(time.Second, 0)
+	require.NotNil(t, f.pick(protoobject.ObjectService_Head_FullMethodName).status)
+	require.Nil(t, f.pick(protoobject.ObjectService_Get_FullMethodName).status)
+	require.Zero(t, f.pick(protoobject.ObjectService_Head_FullMethodName).latency)
+
+	require.Panics(t, func() { f.InjectLatency(time.Second, 1.5) })
+	require.Panics(t, func() { f.DropStreams(-1, 1) })
+	require.Panics(t, func() { f.InjectStatus(nil, 1) })
+}
@@ -231,6 +231,12 @@ var (
 	validMinSetEACLResponseBody = (*protocontainer.SetExtendedACLResponse_Body)(nil)
 	// correct ContainerService.SetExtendedACL response payload with all fields.
 	validFullSetEACLResponseBody = &protocontainer.SetExtendedACLResponse_Body{}
+	// correct ContainerService.AnnounceUsedSpace response payload with required
+	// fields only.
+	validMinAnnounceUsedSpaceResponseBody = (*protocontainer.AnnounceUsedSpaceResponse_Body)(nil)
+	// correct ContainerService.AnnounceUsedSpace response payload with all
+	// fields.
+	validFullAnnounceUsedSpaceResponseBody = &protocontainer.AnnounceUsedSpaceResponse_Body{}
 )
 
 // Netmap service.
@@ -7,6 +7,7 @@ import (
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	protonetmap "github.com/nspcc-dev/neofs-sdk-go/proto/netmap"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
@@ -71,6 +72,10 @@ func (c *Client) EndpointInfo(ctx context.Context, prm PrmEndpointInfo) (*ResEnd
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodEndpointInfo)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	req := &protonetmap.LocalNodeInfoRequest{
 		MetaHeader: &protosession.RequestMetaHeader{
 			Version: c.apiVersion,
@@ -158,6 +163,10 @@ func (c *Client) NetworkInfo(ctx context.Context, prm PrmNetworkInfo) (netmap.Ne
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodNetworkInfo)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	req := &protonetmap.NetworkInfoRequest{
 		MetaHeader: &protosession.RequestMetaHeader{
 			Version: c.apiVersion,
@@ -225,12 +234,16 @@ func (c *Client) NetMapSnapshot(ctx context.Context, _ PrmNetMapSnapshot) (netma
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodNetMapSnapshot)
+	defer func() { telemetry.End(span, err) }()
+
 	req := &protonetmap.NetmapSnapshotRequest{
 		MetaHeader: &protosession.RequestMetaHeader{
 			Version: c.apiVersion,
 			Ttl:     defaultRequestTTL,
 		},
 	}
+	writeXHeadersToMeta(c.traceXHeaders(ctx, nil), req.MetaHeader)
 
 	var res netmap.NetMap
 
@@ -10,6 +10,7 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
@@ -69,6 +70,10 @@ func (c *Client) ObjectDelete(ctx context.Context, containerID cid.ID, objectID
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectDelete, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return oid.ID{}, ErrMissingSigner
 	}
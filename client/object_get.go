@@ -16,6 +16,7 @@ import (
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	igrpc "github.com/nspcc-dev/neofs-sdk-go/internal/grpc"
 	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	protoacl "github.com/nspcc-dev/neofs-sdk-go/proto/acl"
@@ -47,11 +48,23 @@ func (x *prmObjectRead) MarkRaw() {
 	x.raw = true
 }
 
+// IsRaw checks whether the intent to read physically stored object is marked
+// using MarkRaw.
+func (x prmObjectRead) IsRaw() bool {
+	return x.raw
+}
+
 // MarkLocal tells the server to execute the operation locally.
 func (x *prmObjectRead) MarkLocal() {
 	x.local = true
 }
 
+// IsLocal checks whether the operation is marked to be executed locally using
+// MarkLocal.
+func (x prmObjectRead) IsLocal() bool {
+	return x.local
+}
+
 // WithBearerToken attaches bearer token to be used for the operation.
 //
 // If set, underlying eACL rules will be used in access control.
@@ -69,6 +82,7 @@ type PrmObjectGet struct {
 	prmObjectRead
 	skipChecksumVerification bool
 	payloadOnly              bool
+	assembleSplit            bool
 	rng                      *protoobject.Range
 	extendedRange            *protoobject.ExtendedRange
 }
@@ -84,6 +98,19 @@ func (x *PrmObjectGet) MarkPayloadOnly() {
 	x.payloadOnly = true
 }
 
+// AssembleSplit makes the client to reassemble split (large) objects
+// transparently. If the server responds with [object.SplitInfo], the client
+// resolves split-chain children of the object via its link or last part and
+// streams their payloads in order through the same [PayloadReader]. Requested
+// range may span child boundaries. In this case, returned header is the parent
+// one.
+//
+// Note that each child is requested separately, so reading large objects
+// results in several requests.
+func (x *PrmObjectGet) AssembleSplit() {
+	x.assembleSplit = true
+}
+
 // SetRange requests only a byte range of the payload.
 //
 // To request the full payload, leave the range unset or pass both offset and
@@ -174,6 +201,8 @@ type PayloadReader struct {
 
 	statisticCallback shortStatisticCallback
 	startTime         time.Time // if statisticCallback is set only
+	span              streamSpan
+	closeCallbacks    []func()
 
 	requestedOID oid.ID
 	hasRange     bool
@@ -183,6 +212,10 @@ type PayloadReader struct {
 
 	payloadHashCheck []byte
 	payloadHashGot   hash.Hash
+
+	// set if split objects are assembled, see PrmObjectGet.AssembleSplit
+	initSplit func(*object.SplitInfo) (*splitPayloadReader, error)
+	split     *splitPayloadReader
 }
 
 type rawPayloadChunk struct {
@@ -338,9 +371,32 @@ func (x *PayloadReader) readChunk(buf []byte) (int, bool) {
 	}
 }
 
+// switchToSplit switches x to reading of split object payload if the stream
+// was finished with split information and assembly of split objects is
+// enabled. Result means success.
+func (x *PayloadReader) switchToSplit() bool {
+	var siErr *object.SplitInfoError
+	if x.initSplit == nil || !errors.As(x.err, &siErr) {
+		return false
+	}
+
+	x.cancelCtxStream()
+
+	x.split, x.err = x.initSplit(siErr.SplitInfo())
+	if x.err != nil {
+		x.err = fmt.Errorf("assemble split object: %w", x.err)
+		return false
+	}
+	return true
+}
+
 func (x *PayloadReader) close(ignoreEOF bool) error {
 	defer x.cancelCtxStream()
 
+	if x.split != nil {
+		return x.split.Close()
+	}
+
 	x.tail.free()
 	x.tail = rawPayloadChunk{}
 
@@ -359,6 +415,7 @@ func (x *PayloadReader) close(ignoreEOF bool) error {
 }
 
 func (x *PayloadReader) consumePayload(n int) error {
+	x.span.addPayload(n)
 	if !x.enforcePayloadLen {
 		return nil
 	}
@@ -380,15 +437,47 @@ func (x *PayloadReader) Close() error {
 		}()
 	}
 	err = x.close(true)
+	x.span.end(err)
+	for _, f := range x.closeCallbacks {
+		f()
+	}
+	x.closeCallbacks = nil
 	return err
 }
 
+// OnClose registers f to be called once the PayloadReader is closed. It allows
+// wrappers to release resources bound to the reading, e.g. context the stream
+// was opened with.
+func (x *PayloadReader) OnClose(f func()) {
+	x.closeCallbacks = append(x.closeCallbacks, f)
+}
+
+func (x *PayloadReader) readSplit(p []byte) (int, error) {
+	n, err := x.split.Read(p)
+	x.span.addPayload(n)
+	return n, err
+}
+
+func (x *PayloadReader) writeSplitTo(w io.Writer) (int64, error) {
+	n, err := x.split.WriteTo(w)
+	x.span.addPayload(int(n))
+	return n, err
+}
+
 // Read implements io.Reader of the object payload.
 func (x *PayloadReader) Read(p []byte) (int, error) {
+	if x.split != nil {
+		return x.readSplit(p)
+	}
+
 	n, ok := x.readChunk(p)
 	consumeErr := x.consumePayload(n)
 
 	if !ok {
+		if n == 0 && x.switchToSplit() {
+			return x.readSplit(p)
+		}
+
 		err := x.close(false)
 
 		if consumeErr != nil {
@@ -572,6 +661,10 @@ func (x *PayloadReader) recvRawChunk(rawStream getObjectResponseStream) (rawPayl
 // WriteTo writes the remaining object payload to w.
 // It implements [io.WriterTo] and streams chunks without an intermediate read buffer.
 func (x *PayloadReader) WriteTo(w io.Writer) (int64, error) {
+	if x.split != nil {
+		return x.writeSplitTo(w)
+	}
+
 	var written int64
 
 	for {
@@ -602,6 +695,10 @@ func (x *PayloadReader) WriteTo(w io.Writer) (int64, error) {
 
 		chunk, ok := x.recvRawChunk(x.stream)
 		if !ok {
+			if written == 0 && x.switchToSplit() {
+				return x.writeSplitTo(w)
+			}
+
 			err := x.close(false)
 			if errors.Is(err, io.EOF) {
 				return written, nil
@@ -659,11 +756,13 @@ func (x *PayloadReader) WriteTo(w io.Writer) (int64, error) {
 // Return errors:
 //   - global (see Client docs)
 //   - [ErrMissingSigner]
-//   - *[object.SplitInfoError] (returned on virtual objects with PrmObjectGet.MakeRaw)
+//   - *[object.SplitInfoError] (returned on virtual objects with PrmObjectGet.MakeRaw
+//     unless PrmObjectGet.AssembleSplit is used)
 //   - [apistatus.ErrContainerNotFound]
 //   - [apistatus.ErrObjectNotFound]
 //   - [apistatus.ErrObjectAccessDenied]
 //   - [apistatus.ErrObjectAlreadyRemoved]
+//   - [apistatus.ErrObjectOutOfRange] (returned for split objects with PrmObjectGet.AssembleSplit)
 //   - [apistatus.ErrSessionTokenExpired]
 func (c *Client) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm PrmObjectGet) (object.Object, *PayloadReader, error) {
 	var (
@@ -678,6 +777,10 @@ func (c *Client) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectGet, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return hdr, nil, ErrMissingSigner
 	}
@@ -769,9 +872,9 @@ func (c *Client) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID
 		}
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	streamCtx, cancel := context.WithCancel(ctx)
 
-	stream, err := callServerStream(ctx, c.conn, protoobject.ObjectService_Get_FullMethodName, getStreamDesc, reqBuffers)
+	stream, err := callServerStream(streamCtx, c.conn, protoobject.ObjectService_Get_FullMethodName, getStreamDesc, reqBuffers)
 	if err != nil {
 		// buffer is freed by the function
 		cancel()
@@ -799,9 +902,31 @@ func (c *Client) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID
 			c.sendStatistic(stat.MethodObjectGetStream, dur, err)
 		}
 	}
+	r.span = c.startStreamSpan(ctx, stat.MethodObjectGetStream, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+
+	if prm.assembleSplit {
+		r.initSplit = func(si *object.SplitInfo) (*splitPayloadReader, error) {
+			_, sr, err := c.initSplitPayloadReader(ctx, containerID, objectID, signer, prm, si)
+			return sr, err
+		}
+	}
 
 	if !prm.payloadOnly {
 		if !r.readHeader(&hdr) {
+			var siErr *object.SplitInfoError
+			if prm.assembleSplit && errors.As(r.err, &siErr) {
+				cancel()
+				var sr *splitPayloadReader
+				hdr, sr, err = c.initSplitPayloadReader(ctx, containerID, objectID, signer, prm, siErr.SplitInfo())
+				if err != nil {
+					err = fmt.Errorf("assemble split object: %w", err)
+					r.span.end(err)
+					return object.Object{}, nil, err
+				}
+				r.err = nil
+				r.split = sr
+				return hdr, &r, nil
+			}
 			err = fmt.Errorf("read header: %w", r.Close())
 			return hdr, nil, err
 		}
@@ -852,6 +977,10 @@ func (c *Client) ObjectHead(ctx context.Context, containerID cid.ID, objectID oi
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectHead, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return nil, ErrMissingSigner
 	}
@@ -1030,6 +1159,7 @@ type ObjectRangeReader struct {
 
 	statisticCallback shortStatisticCallback
 	startTime         time.Time // if statisticCallback is set only
+	span              streamSpan
 }
 
 func (x *ObjectRangeReader) readChunk(buf []byte) (int, bool) {
@@ -1124,6 +1254,7 @@ func (x *ObjectRangeReader) close(ignoreEOF bool) error {
 }
 
 func (x *ObjectRangeReader) consumePayload(n int) error {
+	x.span.addPayload(n)
 	x.receivedLen += uint64(n)
 	if x.requestedLen > 0 && x.receivedLen > x.requestedLen { // zero means full payload, we don't know its size
 		return errors.New("payload range size overflow")
@@ -1155,6 +1286,7 @@ func (x *ObjectRangeReader) Close() error {
 		}()
 	}
 	err = x.close(true)
+	x.span.end(err)
 	return err
 }
 
@@ -1273,6 +1405,10 @@ func (c *Client) ObjectRangeInit(ctx context.Context, containerID cid.ID, object
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectRange, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if length == 0 && offset != 0 {
 		err = ErrZeroRangeLength
 		return nil, err
@@ -1342,6 +1478,7 @@ func (c *Client) ObjectRangeInit(ctx context.Context, containerID cid.ID, object
 			c.sendStatistic(stat.MethodObjectRangeStream, dur, err)
 		}
 	}
+	r.span = c.startStreamSpan(ctx, stat.MethodObjectRangeStream, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
 
 	return &r, nil
 }
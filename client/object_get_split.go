@@ -0,0 +1,305 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// splitChild is a part of the split object payload to be read.
+type splitChild struct {
+	id oid.ID
+	// range within the child payload, full payload is read if both are zero
+	off, ln uint64
+}
+
+// splitPayloadReader reads payload of the split object by reading its children
+// one by one.
+type splitPayloadReader struct {
+	ctx    context.Context
+	c      *Client
+	cnr    cid.ID
+	signer user.Signer
+	prm    prmObjectRead
+
+	skipChecksumVerification bool
+
+	children []splitChild
+	cur      *PayloadReader
+
+	payloadHashGot   hash.Hash
+	payloadHashCheck []byte
+}
+
+// resolveSplitChain collects children of the split object with given ID using
+// split information returned by the server. Returns parent header and children
+// in split-chain order along with their payload sizes.
+func (c *Client) resolveSplitChain(ctx context.Context, cnr cid.ID, id oid.ID, signer user.Signer, prm prmObjectRead, si *object.SplitInfo) (*object.Object, []object.MeasuredObject, error) {
+	prm.raw = false
+
+	var (
+		parent   *object.Object
+		children []object.MeasuredObject
+	)
+	if link := si.GetLink(); !link.IsZero() {
+		linkHdr, r, err := c.ObjectGetInit(ctx, cnr, link, signer, PrmObjectGet{prmObjectRead: prm})
+		if err != nil {
+			return nil, nil, fmt.Errorf("get link object %s: %w", link, err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			_ = r.Close()
+			return nil, nil, fmt.Errorf("read link object %s payload: %w", link, err)
+		}
+		if err = r.Close(); err != nil {
+			return nil, nil, fmt.Errorf("read link object %s payload: %w", link, err)
+		}
+		if linkHdr.Type() != object.TypeLink {
+			return nil, nil, fmt.Errorf("link object %s has wrong type %s", link, linkHdr.Type())
+		}
+		var l object.Link
+		if err = l.Unmarshal(b); err != nil {
+			return nil, nil, fmt.Errorf("decode link object %s payload: %w", link, err)
+		}
+		parent, children = linkHdr.Parent(), l.Objects()
+	} else if last := si.GetLastPart(); !last.IsZero() {
+		visited := make(map[oid.ID]struct{})
+		for cur := last; !cur.IsZero(); {
+			if _, ok := visited[cur]; ok {
+				return nil, nil, fmt.Errorf("split chain cycle on object %s", cur)
+			}
+			visited[cur] = struct{}{}
+			hdr, err := c.ObjectHead(ctx, cnr, cur, signer, PrmObjectHead{prmObjectRead: prm})
+			if err != nil {
+				return nil, nil, fmt.Errorf("head split chain element %s: %w", cur, err)
+			}
+			if parent == nil {
+				parent = hdr.Parent()
+			}
+			var m object.MeasuredObject
+			m.SetObjectID(cur)
+			m.SetObjectSize(uint32(hdr.PayloadSize()))
+			children = append(children, m)
+			cur = hdr.GetPreviousID()
+		}
+		for i, j := 0, len(children)-1; i < j; i, j = i+1, j-1 {
+			children[i], children[j] = children[j], children[i]
+		}
+	} else {
+		return nil, nil, fmt.Errorf("%w: neither link nor last part is set", errInvalidSplitInfo)
+	}
+
+	if parent == nil {
+		return nil, nil, errors.New("missing parent header in split chain")
+	}
+	if parID := parent.GetID(); parID != id {
+		return nil, nil, fmt.Errorf("split chain parent %s differs from requested object", parID)
+	}
+
+	var total uint64
+	for i := range children {
+		total += uint64(children[i].ObjectSize())
+	}
+	if total != parent.PayloadSize() {
+		return nil, nil, fmt.Errorf("children total payload size %d mismatches parent's %d", total, parent.PayloadSize())
+	}
+
+	return parent, children, nil
+}
+
+// resolvePayloadRange returns offset and length of the payload range requested
+// by given parameters within payload of the specified length.
+func resolvePayloadRange(rng *protoobject.Range, extendedRange *protoobject.ExtendedRange, payloadLen uint64) (uint64, uint64, error) {
+	if rng == nil && extendedRange == nil {
+		return 0, payloadLen, nil
+	}
+	if rng != nil {
+		if rng.Offset+rng.Length < rng.Offset || rng.Offset+rng.Length > payloadLen {
+			return 0, 0, apistatus.ErrObjectOutOfRange
+		}
+		return rng.Offset, rng.Length, nil
+	}
+
+	first, last := extendedRange.FirstPos, extendedRange.LastPos
+	switch {
+	case first != nil:
+		if *first >= payloadLen || last != nil && *last < *first {
+			return 0, 0, apistatus.ErrObjectOutOfRange
+		}
+		return *first, resolvedRangeLength(nil, extendedRange, payloadLen), nil
+	default:
+		ln := resolvedRangeLength(nil, extendedRange, payloadLen)
+		return payloadLen - ln, ln, nil
+	}
+}
+
+// initSplitPayloadReader prepares reader of the split object payload. Returns
+// parent header.
+func (c *Client) initSplitPayloadReader(ctx context.Context, cnr cid.ID, id oid.ID, signer user.Signer, prm PrmObjectGet, si *object.SplitInfo) (object.Object, *splitPayloadReader, error) {
+	parent, children, err := c.resolveSplitChain(ctx, cnr, id, signer, prm.prmObjectRead, si)
+	if err != nil {
+		return object.Object{}, nil, err
+	}
+
+	if !prm.skipChecksumVerification {
+		if err = parent.VerifyID(); err != nil {
+			return object.Object{}, nil, fmt.Errorf("split chain parent header: %w", err)
+		}
+	}
+
+	off, ln, err := resolvePayloadRange(prm.rng, prm.extendedRange, parent.PayloadSize())
+	if err != nil {
+		return object.Object{}, nil, err
+	}
+
+	r := &splitPayloadReader{
+		ctx:                      ctx,
+		c:                        c,
+		cnr:                      cnr,
+		signer:                   signer,
+		prm:                      prm.prmObjectRead,
+		skipChecksumVerification: prm.skipChecksumVerification,
+	}
+	r.prm.raw = false
+
+	if off == 0 && ln == parent.PayloadSize() && !prm.skipChecksumVerification {
+		cs, ok := parent.PayloadChecksum()
+		if !ok {
+			return object.Object{}, nil, errors.New("missing payload hash in header")
+		}
+		r.payloadHashGot = sha256.New()
+		r.payloadHashCheck = cs.Value()
+	}
+
+	var childOff uint64
+	for i := range children {
+		if ln == 0 {
+			break
+		}
+		childLen := uint64(children[i].ObjectSize())
+		if off >= childOff+childLen {
+			childOff += childLen
+			continue
+		}
+		from := off - childOff
+		n := min(childLen-from, ln)
+		ch := splitChild{id: children[i].ObjectID()}
+		if from != 0 || n != childLen {
+			ch.off, ch.ln = from, n
+		}
+		r.children = append(r.children, ch)
+		off += n
+		ln -= n
+		childOff += childLen
+	}
+
+	return *parent, r, nil
+}
+
+// next opens payload stream of the next child. Returns [io.EOF] if there are
+// no more children.
+func (x *splitPayloadReader) next() error {
+	if len(x.children) == 0 {
+		if x.payloadHashGot != nil && !bytes.Equal(x.payloadHashGot.Sum(nil), x.payloadHashCheck) {
+			return errors.New("received payload mismatches checksum from header")
+		}
+		return io.EOF
+	}
+
+	ch := x.children[0]
+	x.children = x.children[1:]
+
+	prm := PrmObjectGet{prmObjectRead: x.prm}
+	if x.skipChecksumVerification {
+		prm.SkipChecksumVerification()
+	}
+	if ch.ln != 0 {
+		prm.SetRange(ch.off, ch.ln)
+	}
+
+	_, r, err := x.c.ObjectGetInit(x.ctx, x.cnr, ch.id, x.signer, prm)
+	if err != nil {
+		return fmt.Errorf("get child object %s: %w", ch.id, err)
+	}
+	x.cur = r
+	return nil
+}
+
+// Read implements io.Reader of the split object payload.
+func (x *splitPayloadReader) Read(p []byte) (int, error) {
+	for {
+		if x.cur == nil {
+			if err := x.next(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := x.cur.Read(p)
+		if x.payloadHashGot != nil {
+			x.payloadHashGot.Write(p[:n])
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return n, fmt.Errorf("read child object payload: %w", err)
+			}
+			err = x.cur.Close()
+			x.cur = nil
+			if err != nil {
+				return n, fmt.Errorf("read child object payload: %w", err)
+			}
+		}
+		if n > 0 || len(p) == 0 {
+			return n, nil
+		}
+	}
+}
+
+// WriteTo writes the remaining split object payload to w.
+func (x *splitPayloadReader) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+	if x.payloadHashGot != nil {
+		w = io.MultiWriter(w, x.payloadHashGot)
+	}
+	for {
+		if x.cur == nil {
+			if err := x.next(); err != nil {
+				if errors.Is(err, io.EOF) {
+					return written, nil
+				}
+				return written, err
+			}
+		}
+
+		n, err := x.cur.WriteTo(w)
+		written += n
+		if err != nil {
+			return written, fmt.Errorf("read child object payload: %w", err)
+		}
+		err = x.cur.Close()
+		x.cur = nil
+		if err != nil {
+			return written, fmt.Errorf("read child object payload: %w", err)
+		}
+	}
+}
+
+// Close closes the current child payload stream.
+func (x *splitPayloadReader) Close() error {
+	if x.cur == nil {
+		return nil
+	}
+	err := x.cur.Close()
+	x.cur = nil
+	return err
+}
@@ -0,0 +1,339 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/testutil"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testSplitObjectServer is an in-memory ObjectService server storing physical
+// objects and responding with split information for virtual ones.
+type testSplitObjectServer struct {
+	protoobject.UnimplementedObjectServiceServer
+	objs   map[oid.ID]object.Object
+	splits map[oid.ID]*object.SplitInfo
+}
+
+func (x *testSplitObjectServer) Get(req *protoobject.GetRequest, stream protoobject.ObjectService_GetServer) error {
+	var id oid.ID
+	if err := id.FromProtoMessage(req.GetBody().GetAddress().GetObjectId()); err != nil {
+		return err
+	}
+	if si, ok := x.splits[id]; ok {
+		return stream.Send(&protoobject.GetResponse{Body: &protoobject.GetResponse_Body{
+			ObjectPart: &protoobject.GetResponse_Body_SplitInfo{SplitInfo: si.ProtoMessage()},
+		}})
+	}
+	obj, ok := x.objs[id]
+	if !ok {
+		return status.Error(codes.NotFound, "object not found")
+	}
+	payload := obj.Payload()
+	if rng := req.GetBody().GetRange(); rng != nil {
+		payload = payload[rng.Offset : rng.Offset+rng.Length]
+	}
+	if !req.GetBody().GetPayloadOnly() {
+		m := obj.ProtoMessage()
+		if err := stream.Send(&protoobject.GetResponse{Body: &protoobject.GetResponse_Body{
+			ObjectPart: &protoobject.GetResponse_Body_Init_{Init: &protoobject.GetResponse_Body_Init{
+				ObjectId: m.ObjectId, Signature: m.Signature, Header: m.Header,
+			}},
+		}}); err != nil {
+			return err
+		}
+	}
+	for len(payload) > 0 {
+		n := min(len(payload), 3)
+		if err := stream.Send(&protoobject.GetResponse{Body: &protoobject.GetResponse_Body{
+			ObjectPart: &protoobject.GetResponse_Body_Chunk{Chunk: payload[:n]},
+		}}); err != nil {
+			return err
+		}
+		payload = payload[n:]
+	}
+	return nil
+}
+
+func (x *testSplitObjectServer) Head(_ context.Context, req *protoobject.HeadRequest) (*protoobject.HeadResponse, error) {
+	var id oid.ID
+	if err := id.FromProtoMessage(req.GetBody().GetAddress().GetObjectId()); err != nil {
+		return nil, err
+	}
+	obj, ok := x.objs[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "object not found")
+	}
+	m := obj.ProtoMessage()
+	return &protoobject.HeadResponse{Body: &protoobject.HeadResponse_Body{
+		Head: &protoobject.HeadResponse_Body_Header{Header: &protoobject.HeaderWithSignature{
+			Header: m.Header, Signature: m.Signature,
+		}},
+	}}, nil
+}
+
+// splits payload into children of given size and stores them with the link
+// object in the server. Returns parent object.
+func (x *testSplitObjectServer) putSplitObject(t testing.TB, cnr cid.ID, payload []byte, childSize int) object.Object {
+	signer := usertest.User()
+
+	parent := object.New(cnr, signer.ID)
+	parent.SetPayload(payload)
+	parent.SetPayloadSize(uint64(len(payload)))
+	parent.SetAttributes(object.NewAttribute("attr", "val"))
+	require.NoError(t, parent.SetVerificationFields(signer))
+	parentHdr := parent.CutPayload()
+
+	var (
+		children []object.MeasuredObject
+		prev     oid.ID
+	)
+	for off := 0; off < len(payload); off += childSize {
+		chunk := payload[off:min(off+childSize, len(payload))]
+		child := object.New(cnr, signer.ID)
+		if !prev.IsZero() {
+			child.SetPreviousID(prev)
+			child.SetFirstID(children[0].ObjectID())
+		}
+		if off+childSize >= len(payload) {
+			child.SetParent(parentHdr)
+		}
+		child.SetPayload(chunk)
+		child.SetPayloadSize(uint64(len(chunk)))
+		require.NoError(t, child.SetVerificationFields(signer))
+		x.objs[child.GetID()] = *child
+
+		var m object.MeasuredObject
+		m.SetObjectID(child.GetID())
+		m.SetObjectSize(uint32(len(chunk)))
+		children = append(children, m)
+		prev = child.GetID()
+	}
+
+	var l object.Link
+	l.SetObjects(children)
+	link := object.New(cnr, signer.ID)
+	link.SetType(object.TypeLink)
+	link.SetParent(parentHdr)
+	link.SetFirstID(children[0].ObjectID())
+	link.WriteLink(l)
+	link.SetPayloadSize(uint64(len(link.Payload())))
+	require.NoError(t, link.SetVerificationFields(signer))
+	x.objs[link.GetID()] = *link
+
+	si := object.NewSplitInfo()
+	si.SetLink(link.GetID())
+	si.SetLastPart(prev)
+	si.SetFirstPart(children[0].ObjectID())
+	x.splits[parent.GetID()] = si
+
+	return *parent
+}
+
+func newTestSplitObjectServer() *testSplitObjectServer {
+	return &testSplitObjectServer{
+		objs:   make(map[oid.ID]object.Object),
+		splits: make(map[oid.ID]*object.SplitInfo),
+	}
+}
+
+func TestClient_ObjectGetInit_AssembleSplit(t *testing.T) {
+	ctx := context.Background()
+	cnr := cidtest.ID()
+	signer := usertest.User()
+	payload := testutil.RandByteSlice(100)
+
+	srv := newTestSplitObjectServer()
+	parent := srv.putSplitObject(t, cnr, payload, 30)
+	c := newTestObjectClient(t, srv)
+
+	t.Run("disabled", func(t *testing.T) {
+		_, _, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, PrmObjectGet{})
+		var siErr *object.SplitInfoError
+		require.ErrorAs(t, err, &siErr)
+	})
+
+	for _, tc := range []struct {
+		name   string
+		setup  func(*PrmObjectGet)
+		expect []byte
+	}{
+		{name: "full", setup: func(*PrmObjectGet) {}, expect: payload},
+		{name: "range/within child", setup: func(p *PrmObjectGet) { p.SetRange(31, 10) }, expect: payload[31:41]},
+		{name: "range/child boundaries", setup: func(p *PrmObjectGet) { p.SetRange(30, 30) }, expect: payload[30:60]},
+		{name: "range/across children", setup: func(p *PrmObjectGet) { p.SetRange(25, 70) }, expect: payload[25:95]},
+		{name: "range/tail", setup: func(p *PrmObjectGet) { p.SetRange(95, 5) }, expect: payload[95:]},
+		{name: "range bounds", setup: func(p *PrmObjectGet) { p.SetRangeBounds(10, 64) }, expect: payload[10:65]},
+		{name: "range bounds/trimmed", setup: func(p *PrmObjectGet) { p.SetRangeBounds(50, 1000) }, expect: payload[50:]},
+		{name: "range from", setup: func(p *PrmObjectGet) { p.SetRangeFrom(42) }, expect: payload[42:]},
+		{name: "range suffix", setup: func(p *PrmObjectGet) { p.SetRangeSuffix(33) }, expect: payload[67:]},
+		{name: "range suffix/trimmed", setup: func(p *PrmObjectGet) { p.SetRangeSuffix(1000) }, expect: payload},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, payloadOnly := range []bool{false, true} {
+				for _, writeTo := range []bool{false, true} {
+					var prm PrmObjectGet
+					prm.MarkRaw()
+					prm.AssembleSplit()
+					tc.setup(&prm)
+					if payloadOnly {
+						prm.MarkPayloadOnly()
+					}
+
+					hdr, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+					require.NoError(t, err)
+					if payloadOnly {
+						require.Zero(t, hdr)
+					} else {
+						require.Equal(t, *parent.CutPayload(), hdr)
+					}
+
+					var b []byte
+					if writeTo {
+						var buf bytes.Buffer
+						_, err = r.WriteTo(&buf)
+						b = buf.Bytes()
+					} else {
+						b, err = io.ReadAll(r)
+					}
+					require.NoError(t, err)
+					require.NoError(t, r.Close())
+					require.Equal(t, tc.expect, b)
+				}
+			}
+		})
+	}
+
+	t.Run("last part only", func(t *testing.T) {
+		srv := newTestSplitObjectServer()
+		parent := srv.putSplitObject(t, cnr, payload, 40)
+		srv.splits[parent.GetID()].SetLink(oid.ID{})
+		c := newTestObjectClient(t, srv)
+
+		var prm PrmObjectGet
+		prm.AssembleSplit()
+		prm.SetRange(35, 10)
+		_, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, payload[35:45], b)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		for _, setup := range []func(*PrmObjectGet){
+			func(p *PrmObjectGet) { p.SetRange(90, 11) },
+			func(p *PrmObjectGet) { p.SetRangeFrom(100) },
+			func(p *PrmObjectGet) { p.SetRangeBounds(100, 101) },
+		} {
+			var prm PrmObjectGet
+			prm.AssembleSplit()
+			setup(&prm)
+			_, _, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+			require.ErrorIs(t, err, apistatus.ErrObjectOutOfRange)
+
+			prm.MarkPayloadOnly()
+			_, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+			require.NoError(t, err)
+			_, err = r.Read(make([]byte, 1))
+			require.ErrorIs(t, err, apistatus.ErrObjectOutOfRange)
+		}
+	})
+
+	t.Run("broken chain", func(t *testing.T) {
+		for _, tc := range []struct {
+			name, err string
+			corrupt   func(*testSplitObjectServer, object.Object)
+		}{
+			{name: "missing link", err: "get link object",
+				corrupt: func(srv *testSplitObjectServer, parent object.Object) {
+					delete(srv.objs, srv.splits[parent.GetID()].GetLink())
+				}},
+			{name: "other parent", err: "differs from requested object",
+				corrupt: func(srv *testSplitObjectServer, parent object.Object) {
+					other := srv.putSplitObject(t, cnr, payload, 30)
+					srv.splits[parent.GetID()] = srv.splits[other.GetID()]
+				}},
+			{name: "size mismatch", err: "children total payload size 99 mismatches parent's 100",
+				corrupt: func(srv *testSplitObjectServer, parent object.Object) {
+					link := srv.objs[srv.splits[parent.GetID()].GetLink()]
+					var l object.Link
+					require.NoError(t, link.ReadLink(&l))
+					children := l.Objects()
+					children[0].SetObjectSize(children[0].ObjectSize() - 1)
+					l.SetObjects(children)
+					link.WriteLink(l)
+					require.NoError(t, link.SetVerificationFields(usertest.User()))
+					srv.objs[link.GetID()] = link
+					srv.splits[parent.GetID()].SetLink(link.GetID())
+				}},
+			{name: "missing child", err: "get child object",
+				corrupt: func(srv *testSplitObjectServer, parent object.Object) {
+					delete(srv.objs, srv.splits[parent.GetID()].GetFirstPart())
+				}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				srv := newTestSplitObjectServer()
+				parent := srv.putSplitObject(t, cnr, payload, 30)
+				tc.corrupt(srv, parent)
+				c := newTestObjectClient(t, srv)
+
+				var prm PrmObjectGet
+				prm.AssembleSplit()
+				prm.SkipChecksumVerification()
+				_, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+				if err == nil {
+					_, err = io.ReadAll(r)
+				}
+				require.ErrorContains(t, err, tc.err)
+			})
+		}
+	})
+
+	t.Run("corrupted payload", func(t *testing.T) {
+		srv := newTestSplitObjectServer()
+		parent := srv.putSplitObject(t, cnr, payload, 30)
+		first := srv.objs[srv.splits[parent.GetID()].GetFirstPart()]
+		pld := bytes.Clone(first.Payload())
+		pld[0]++
+		first.SetPayload(pld)
+		srv.objs[first.GetID()] = first
+		c := newTestObjectClient(t, srv)
+
+		var prm PrmObjectGet
+		prm.AssembleSplit()
+		_, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorContains(t, err, "received payload mismatches checksum from header")
+
+		prm.SkipChecksumVerification()
+		_, r, err = c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, pld, b[:30])
+	})
+
+	t.Run("close in the middle", func(t *testing.T) {
+		var prm PrmObjectGet
+		prm.AssembleSplit()
+		_, r, err := c.ObjectGetInit(ctx, cnr, parent.GetID(), signer, prm)
+		require.NoError(t, err)
+		_, err = io.ReadFull(r, make([]byte, 45))
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+	})
+}
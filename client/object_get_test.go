@@ -577,6 +577,8 @@ func TestClient_ObjectHead(t *testing.T) {
 
 					opts := anyValidOpts
 					opts.MarkLocal()
+					require.True(t, opts.IsLocal())
+					require.False(t, opts.IsRaw())
 
 					srv.checkRequestLocal()
 					srv.requireUnsignedRequest()
@@ -590,6 +592,8 @@ func TestClient_ObjectHead(t *testing.T) {
 
 					opts := anyValidOpts
 					opts.MarkRaw()
+					require.True(t, opts.IsRaw())
+					require.False(t, opts.IsLocal())
 
 					srv.checkRequestRaw()
 					_, err := c.ObjectHead(ctx, anyCID, anyOID, anyValidSigner, opts)
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/bearer"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+// tzHashSize is a size of Tillich-Zémor homomorphic hash.
+const tzHashSize = 64
+
+// PrmObjectHash groups optional parameters of ObjectHash operation.
+type PrmObjectHash struct {
+	prmCommonMeta
+	sessionContainer
+	bearerToken *bearer.Token
+	local       bool
+}
+
+// MarkLocal tells the server to execute the operation locally.
+func (x *PrmObjectHash) MarkLocal() {
+	x.local = true
+}
+
+// WithBearerToken attaches bearer token to be used for the operation.
+//
+// If set, underlying eACL rules will be used in access control.
+//
+// Must be signed.
+func (x *PrmObjectHash) WithBearerToken(t bearer.Token) {
+	x.bearerToken = &t
+}
+
+// ObjectHash requests checksums of the specified payload ranges of the
+// referenced object using NeoFS API protocol. Checksums are calculated by the
+// server according to the given type. If salt is set, the server XORs each
+// range with it before hashing. Result is ordered as ranges.
+//
+// Any client's internal or transport errors are returned as `error`,
+// see [apistatus] package for NeoFS-specific error types.
+//
+// Context is required and must not be nil. It is used for network communication.
+//
+// Signer is required and must not be nil. The operation is executed on behalf of
+// the account corresponding to the specified Signer, which is taken into account, in particular, for access control.
+//
+// Return errors:
+//   - global (see Client docs)
+//   - [ErrMissingSigner]
+//   - [ErrMissingRanges]
+//   - [ErrZeroRangeLength]
+//   - [apistatus.ErrContainerNotFound]
+//   - [apistatus.ErrObjectNotFound]
+//   - [apistatus.ErrObjectAccessDenied]
+//   - [apistatus.ErrObjectAlreadyRemoved]
+//   - [apistatus.ErrObjectOutOfRange]
+//   - [apistatus.ErrSessionTokenExpired]
+func (c *Client) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, ranges []object.Range, typ checksum.Type, salt []byte, signer user.Signer, prm PrmObjectHash) ([]checksum.Checksum, error) {
+	var err error
+
+	if c.prm.statisticCallback != nil {
+		startTime := time.Now()
+		defer func() {
+			c.sendStatistic(stat.MethodObjectHash, time.Since(startTime), err)
+		}()
+	}
+
+	ctx, span := c.startSpan(ctx, stat.MethodObjectHash, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
+	if signer == nil {
+		return nil, ErrMissingSigner
+	}
+	if len(ranges) == 0 {
+		err = ErrMissingRanges
+		return nil, err
+	}
+	var (
+		protoTyp refs.ChecksumType
+		hashLen  int
+	)
+	switch typ {
+	default:
+		err = fmt.Errorf("unsupported checksum type %d", typ)
+		return nil, err
+	case checksum.SHA256:
+		protoTyp, hashLen = refs.ChecksumType_SHA256, sha256.Size
+	case checksum.TillichZemor:
+		protoTyp, hashLen = refs.ChecksumType_TZ, tzHashSize
+	}
+	if prm.session != nil && prm.sessionV2 != nil {
+		return nil, errSessionTokenBothVersionsSet
+	}
+
+	req := &protoobject.GetRangeHashRequest{
+		Body: &protoobject.GetRangeHashRequest_Body{
+			Address: oid.NewAddress(containerID, objectID).ProtoMessage(),
+			Ranges:  make([]*protoobject.Range, len(ranges)),
+			Salt:    salt,
+			Type:    protoTyp,
+		},
+		MetaHeader: &protosession.RequestMetaHeader{
+			Version: c.apiVersion,
+			Ttl:     defaultRequestTTL,
+		},
+	}
+	for i := range ranges {
+		ln := ranges[i].GetLength()
+		if ln == 0 {
+			err = fmt.Errorf("%w: range #%d", ErrZeroRangeLength, i)
+			return nil, err
+		}
+		req.Body.Ranges[i] = &protoobject.Range{Offset: ranges[i].GetOffset(), Length: ln}
+	}
+	if prm.local {
+		req.MetaHeader.Ttl = localRequestTTL
+	}
+	writeXHeadersToMeta(prm.xHeaders, req.MetaHeader)
+	if prm.session != nil {
+		req.MetaHeader.SessionToken = prm.session.ProtoMessage()
+	}
+	if prm.sessionV2 != nil {
+		req.MetaHeader.SessionTokenV2 = prm.sessionV2.ProtoMessage()
+	}
+	if prm.bearerToken != nil {
+		req.MetaHeader.BearerToken = prm.bearerToken.ProtoMessage()
+	}
+
+	if c.shouldSignRequest(req.MetaHeader.Ttl) {
+		buf := c.buffers.Get().(*[]byte)
+		defer func() { c.buffers.Put(buf) }()
+
+		req.VerifyHeader, err = neofscrypto.SignRequestWithBuffer[*protoobject.GetRangeHashRequest_Body](signer, req, *buf)
+		if err != nil {
+			err = fmt.Errorf("%w: %w", errSignRequest, err)
+			return nil, err
+		}
+	}
+
+	resp, err := c.object.GetRangeHash(ctx, req)
+	if err != nil {
+		err = rpcErr(err)
+		return nil, err
+	}
+
+	if err = apistatus.ToError(resp.GetMetaHeader().GetStatus()); err != nil {
+		return nil, err
+	}
+
+	const fieldHashList = "hash list"
+
+	body := resp.GetBody()
+	if respTyp := body.GetType(); respTyp != protoTyp {
+		err = newErrInvalidResponseField("type", fmt.Errorf("requested %s, got %s", protoTyp, respTyp))
+		return nil, err
+	}
+
+	hs := body.GetHashList()
+	if len(hs) == 0 {
+		err = newErrMissingResponseField(fieldHashList)
+		return nil, err
+	}
+	if len(hs) != len(ranges) {
+		err = newErrInvalidResponseField(fieldHashList, fmt.Errorf("wrong number of hashes: requested %d, got %d", len(ranges), len(hs)))
+		return nil, err
+	}
+
+	res := make([]checksum.Checksum, len(hs))
+	for i := range hs {
+		if len(hs[i]) != hashLen {
+			err = newErrInvalidResponseField(fieldHashList, fmt.Errorf("invalid element #%d: wrong length %d, expected %d", i, len(hs[i]), hashLen))
+			return nil, err
+		}
+		res[i] = checksum.New(typ, hs[i])
+	}
+
+	return res, nil
+}
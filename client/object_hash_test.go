@@ -0,0 +1,450 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	sessiontest "github.com/nspcc-dev/neofs-sdk-go/session/test"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type testHashObjectServer struct {
+	protoobject.UnimplementedObjectServiceServer
+	testCommonUnaryServerSettings[
+		*protoobject.GetRangeHashRequest_Body,
+		*protoobject.GetRangeHashRequest,
+		*protoobject.GetRangeHashResponse_Body,
+		*protoobject.GetRangeHashResponse,
+	]
+	testObjectSessionServerSettings
+	testBearerTokenServerSettings
+	testObjectAddressServerSettings
+	testLocalRequestServerSettings
+	reqRanges []*protoobject.Range
+	reqType   *refs.ChecksumType
+	reqSalt   []byte
+}
+
+// returns [protoobject.ObjectServiceServer] supporting GetRangeHash method
+// only. Default implementation performs common verification of any request,
+// and responds with any valid message. Some methods allow to tune the behavior.
+func newTestHashObjectServer() *testHashObjectServer { return new(testHashObjectServer) }
+
+// makes the server to assert that any request carries given ranges. By
+// default, any valid ranges are accepted.
+func (x *testHashObjectServer) checkRequestRanges(rs []object.Range) {
+	x.reqRanges = make([]*protoobject.Range, len(rs))
+	for i := range rs {
+		x.reqRanges[i] = &protoobject.Range{Offset: rs[i].GetOffset(), Length: rs[i].GetLength()}
+	}
+}
+
+// makes the server to assert that any request carries given checksum type. By
+// default, any supported type is accepted.
+func (x *testHashObjectServer) checkRequestType(typ refs.ChecksumType) { x.reqType = &typ }
+
+// makes the server to assert that any request carries given salt. By default,
+// and if nil, salt must be empty.
+func (x *testHashObjectServer) checkRequestSalt(salt []byte) { x.reqSalt = salt }
+
+func (x *testHashObjectServer) verifyRequest(req *protoobject.GetRangeHashRequest) error {
+	if err := x.testCommonUnaryServerSettings.verifyRequest(req); err != nil {
+		return err
+	}
+	// meta header
+	if err := x.verifyTTL(req.MetaHeader); err != nil {
+		return err
+	}
+	if req.MetaHeader.SessionToken != nil && req.MetaHeader.SessionTokenV2 != nil {
+		return newInvalidRequestMetaHeaderErr(errors.New("both session token and session token v2 are set"))
+	}
+	if err := x.verifySessionToken(req.MetaHeader.SessionToken); err != nil {
+		return err
+	}
+	if err := x.verifySessionTokenV2(req.MetaHeader.SessionTokenV2); err != nil {
+		return err
+	}
+	if err := x.verifyBearerToken(req.MetaHeader.BearerToken); err != nil {
+		return err
+	}
+	// body
+	body := req.Body
+	if body == nil {
+		return newInvalidRequestBodyErr(errors.New("missing body"))
+	}
+	// 1. address
+	if err := x.verifyObjectAddress(body.Address); err != nil {
+		return err
+	}
+	// 2. ranges
+	if len(body.Ranges) == 0 {
+		return newErrMissingRequestBodyField("ranges")
+	}
+	for i := range body.Ranges {
+		if body.Ranges[i] == nil {
+			return newErrInvalidRequestField("ranges", fmt.Errorf("nil element #%d", i))
+		}
+		if body.Ranges[i].Length == 0 {
+			return newErrInvalidRequestField("ranges", fmt.Errorf("zero length of element #%d", i))
+		}
+	}
+	if x.reqRanges != nil {
+		if len(body.Ranges) != len(x.reqRanges) {
+			return newErrInvalidRequestField("ranges", fmt.Errorf("number of elements (client: %d, message: %d)",
+				len(x.reqRanges), len(body.Ranges)))
+		}
+		for i := range x.reqRanges {
+			if !proto.Equal(x.reqRanges[i], body.Ranges[i]) {
+				return newErrInvalidRequestField("ranges", fmt.Errorf("element #%d (client: %v, message: %v)",
+					i, x.reqRanges[i], body.Ranges[i]))
+			}
+		}
+	}
+	// 3. salt
+	if !bytes.Equal(body.Salt, x.reqSalt) {
+		return newErrInvalidRequestField("salt", fmt.Errorf("unexpected value (client: %x, message: %x)", x.reqSalt, body.Salt))
+	}
+	// 4. type
+	if body.Type != refs.ChecksumType_SHA256 && body.Type != refs.ChecksumType_TZ {
+		return newErrInvalidRequestField("type", fmt.Errorf("unsupported value %v", body.Type))
+	}
+	if x.reqType != nil && body.Type != *x.reqType {
+		return newErrInvalidRequestField("type", fmt.Errorf("unexpected value (client: %v, message: %v)", *x.reqType, body.Type))
+	}
+	return nil
+}
+
+func (x *testHashObjectServer) GetRangeHash(_ context.Context, req *protoobject.GetRangeHashRequest) (*protoobject.GetRangeHashResponse, error) {
+	time.Sleep(x.handlerSleepDur)
+	if err := x.verifyRequest(req); err != nil {
+		return nil, err
+	}
+	if x.handlerErr != nil {
+		return nil, x.handlerErr
+	}
+
+	resp := &protoobject.GetRangeHashResponse{
+		MetaHeader: x.respMeta,
+	}
+	if x.respBodyForced {
+		resp.Body = x.respBody
+	} else {
+		hashLen := 32
+		if req.Body.Type == refs.ChecksumType_TZ {
+			hashLen = 64
+		}
+		resp.Body = &protoobject.GetRangeHashResponse_Body{
+			Type:     req.Body.Type,
+			HashList: make([][]byte, len(req.Body.Ranges)),
+		}
+		for i := range resp.Body.HashList {
+			resp.Body.HashList[i] = bytes.Repeat([]byte{byte(i)}, hashLen)
+		}
+	}
+
+	var err error
+	resp.VerifyHeader, err = x.signResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("sign response: %w", err)
+	}
+	return resp, nil
+}
+
+func newObjectRange(off, ln uint64) object.Range {
+	var r object.Range
+	r.SetOffset(off)
+	r.SetLength(ln)
+	return r
+}
+
+func TestClient_ObjectHash(t *testing.T) {
+	ctx := context.Background()
+	var anyValidOpts PrmObjectHash
+	anyCID := cidtest.ID()
+	anyOID := oidtest.ID()
+	anyValidRanges := []object.Range{newObjectRange(0, 10), newObjectRange(5, 15)}
+	anyValidSigner := usertest.User()
+
+	t.Run("messages", func(t *testing.T) {
+		/*
+			This test is dedicated for cases when user input results in sending a certain
+			request to the server and receiving a specific response to it. For user input
+			errors, transport, client internals, etc. see/add other tests.
+		*/
+		t.Run("requests", func(t *testing.T) {
+			t.Run("required data", func(t *testing.T) {
+				for _, tc := range []struct {
+					name     string
+					typ      checksum.Type
+					protoTyp refs.ChecksumType
+				}{
+					{name: "SHA-256", typ: checksum.SHA256, protoTyp: refs.ChecksumType_SHA256},
+					{name: "Tillich-Zemor", typ: checksum.TillichZemor, protoTyp: refs.ChecksumType_TZ},
+				} {
+					t.Run(tc.name, func(t *testing.T) {
+						srv := newTestHashObjectServer()
+						c := newTestObjectClient(t, srv)
+
+						srv.checkRequestObjectAddress(anyCID, anyOID)
+						srv.checkRequestRanges(anyValidRanges)
+						srv.checkRequestType(tc.protoTyp)
+						srv.authenticateRequest(anyValidSigner)
+						_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, tc.typ, nil, anyValidSigner, PrmObjectHash{})
+						require.NoError(t, err)
+					})
+				}
+			})
+			t.Run("salt", func(t *testing.T) {
+				srv := newTestHashObjectServer()
+				c := newTestObjectClient(t, srv)
+
+				salt := []byte("any salt")
+				srv.checkRequestSalt(salt)
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, salt, anyValidSigner, anyValidOpts)
+				require.NoError(t, err)
+			})
+			t.Run("options", func(t *testing.T) {
+				t.Run("X-headers", func(t *testing.T) {
+					testRequestXHeaders(t, newTestHashObjectServer, newTestObjectClient, func(c *Client, xhs []string) error {
+						opts := anyValidOpts
+						opts.WithXHeaders(xhs...)
+						_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+						return err
+					})
+				})
+				t.Run("local", func(t *testing.T) {
+					srv := newTestHashObjectServer()
+					c := newTestObjectClient(t, srv)
+
+					opts := anyValidOpts
+					opts.MarkLocal()
+
+					srv.checkRequestLocal()
+					srv.requireUnsignedRequest()
+					c.SkipSignatureForLocalRequests()
+					_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+					require.NoError(t, err)
+				})
+				t.Run("session token", func(t *testing.T) {
+					srv := newTestHashObjectServer()
+					c := newTestObjectClient(t, srv)
+
+					st := sessiontest.ObjectSigned(usertest.User())
+					opts := anyValidOpts
+					opts.WithinSession(st)
+
+					srv.checkRequestSessionToken(st)
+					_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+					require.NoError(t, err)
+				})
+				t.Run("session token V2", func(t *testing.T) {
+					srv := newTestHashObjectServer()
+					c := newTestObjectClient(t, srv)
+
+					st := sessiontest.TokenSigned(usertest.User())
+					opts := anyValidOpts
+					opts.WithinSessionV2(st)
+
+					srv.checkRequestSessionTokenV2(st)
+					_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+					require.NoError(t, err)
+				})
+				t.Run("bearer token", func(t *testing.T) {
+					srv := newTestHashObjectServer()
+					c := newTestObjectClient(t, srv)
+
+					bt := bearertest.Token()
+					require.NoError(t, bt.Sign(usertest.User()))
+					opts := anyValidOpts
+					opts.WithBearerToken(bt)
+
+					srv.checkRequestBearerToken(bt)
+					_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+					require.NoError(t, err)
+				})
+			})
+		})
+		t.Run("responses", func(t *testing.T) {
+			t.Run("valid", func(t *testing.T) {
+				t.Run("payloads", func(t *testing.T) {
+					for _, tc := range []struct {
+						name     string
+						typ      checksum.Type
+						protoTyp refs.ChecksumType
+						hashLen  int
+					}{
+						{name: "SHA-256", typ: checksum.SHA256, protoTyp: refs.ChecksumType_SHA256, hashLen: 32},
+						{name: "Tillich-Zemor", typ: checksum.TillichZemor, protoTyp: refs.ChecksumType_TZ, hashLen: 64},
+					} {
+						t.Run(tc.name, func(t *testing.T) {
+							srv := newTestHashObjectServer()
+							c := newTestObjectClient(t, srv)
+
+							body := &protoobject.GetRangeHashResponse_Body{
+								Type: tc.protoTyp,
+								HashList: [][]byte{
+									bytes.Repeat([]byte{1}, tc.hashLen),
+									bytes.Repeat([]byte{2}, tc.hashLen),
+								},
+							}
+							srv.respondWithBody(body)
+							res, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, tc.typ, nil, anyValidSigner, anyValidOpts)
+							require.NoError(t, err)
+							require.Len(t, res, len(body.HashList))
+							for i := range res {
+								require.Equal(t, tc.typ, res[i].Type())
+								require.Equal(t, body.HashList[i], res[i].Value())
+							}
+						})
+					}
+				})
+				t.Run("statuses", func(t *testing.T) {
+					testStatusResponses(t, newTestHashObjectServer, newTestObjectClient, func(c *Client) error {
+						_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+						return err
+					})
+				})
+			})
+			t.Run("invalid", func(t *testing.T) {
+				t.Run("format", func(t *testing.T) {
+					testIncorrectUnaryRPCResponseFormat(t, "object.ObjectService", "GetRangeHash", func(c *Client) error {
+						_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+						return err
+					})
+				})
+				t.Run("payloads", func(t *testing.T) {
+					type testcase = invalidResponseBodyTestcase[protoobject.GetRangeHashResponse_Body]
+					validHash := make([]byte, 32)
+					tcs := []testcase{
+						{name: "nil", body: nil, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid type field in the response: requested SHA256, got CHECKSUM_TYPE_UNSPECIFIED")
+						}},
+						{name: "type/wrong", body: &protoobject.GetRangeHashResponse_Body{
+							Type:     refs.ChecksumType_TZ,
+							HashList: [][]byte{validHash, validHash},
+						}, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid type field in the response: requested SHA256, got TZ")
+						}},
+						{name: "hash list/missing", body: &protoobject.GetRangeHashResponse_Body{
+							Type: refs.ChecksumType_SHA256,
+						}, assertErr: func(t testing.TB, err error) {
+							require.ErrorIs(t, err, ErrMissingResponseField)
+							require.EqualError(t, err, "missing hash list field in the response")
+						}},
+						{name: "hash list/fewer", body: &protoobject.GetRangeHashResponse_Body{
+							Type:     refs.ChecksumType_SHA256,
+							HashList: [][]byte{validHash},
+						}, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid hash list field in the response: wrong number of hashes: requested 2, got 1")
+						}},
+						{name: "hash list/more", body: &protoobject.GetRangeHashResponse_Body{
+							Type:     refs.ChecksumType_SHA256,
+							HashList: [][]byte{validHash, validHash, validHash},
+						}, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid hash list field in the response: wrong number of hashes: requested 2, got 3")
+						}},
+						{name: "hash list/element/empty", body: &protoobject.GetRangeHashResponse_Body{
+							Type:     refs.ChecksumType_SHA256,
+							HashList: [][]byte{validHash, {}},
+						}, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid hash list field in the response: invalid element #1: wrong length 0, expected 32")
+						}},
+						{name: "hash list/element/wrong length", body: &protoobject.GetRangeHashResponse_Body{
+							Type:     refs.ChecksumType_SHA256,
+							HashList: [][]byte{make([]byte, 64), validHash},
+						}, assertErr: func(t testing.TB, err error) {
+							require.EqualError(t, err, "invalid hash list field in the response: invalid element #0: wrong length 64, expected 32")
+						}},
+					}
+
+					testInvalidResponseBodies(t, newTestHashObjectServer, newTestObjectClient, tcs, func(c *Client) error {
+						_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+						return err
+					})
+				})
+			})
+		})
+	})
+	t.Run("invalid user input", func(t *testing.T) {
+		c := newClient(t)
+		t.Run("missing signer", func(t *testing.T) {
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, nil, anyValidOpts)
+			require.ErrorIs(t, err, ErrMissingSigner)
+		})
+		t.Run("missing ranges", func(t *testing.T) {
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, nil, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+			require.ErrorIs(t, err, ErrMissingRanges)
+			_, err = c.ObjectHash(ctx, anyCID, anyOID, []object.Range{}, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+			require.ErrorIs(t, err, ErrMissingRanges)
+		})
+		t.Run("zero range length", func(t *testing.T) {
+			rs := []object.Range{newObjectRange(0, 1), newObjectRange(1, 0)}
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, rs, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+			require.ErrorIs(t, err, ErrZeroRangeLength)
+			require.EqualError(t, err, "zero range length: range #1")
+		})
+		t.Run("unsupported checksum type", func(t *testing.T) {
+			for _, typ := range []checksum.Type{0, checksum.TillichZemor + 1} {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, typ, nil, anyValidSigner, anyValidOpts)
+				require.EqualError(t, err, fmt.Sprintf("unsupported checksum type %d", typ))
+			}
+		})
+		t.Run("both session tokens", func(t *testing.T) {
+			opts := anyValidOpts
+			opts.WithinSession(sessiontest.Object())
+			opts.WithinSessionV2(sessiontest.Token())
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, opts)
+			require.ErrorIs(t, err, errSessionTokenBothVersionsSet)
+		})
+	})
+	t.Run("context", func(t *testing.T) {
+		testContextErrors(t, newTestHashObjectServer, newTestObjectClient, func(ctx context.Context, c *Client) error {
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+			return err
+		})
+	})
+	t.Run("sign request failure", func(t *testing.T) {
+		_, err := newClient(t).ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, usertest.FailSigner(anyValidSigner), anyValidOpts)
+		assertSignRequestErr(t, err)
+	})
+	t.Run("transport failure", func(t *testing.T) {
+		testTransportFailure(t, newTestHashObjectServer, newTestObjectClient, func(c *Client) error {
+			_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+			return err
+		})
+	})
+	t.Run("exec statistics", func(t *testing.T) {
+		testStatistic(t, newTestHashObjectServer, newDefaultObjectService, stat.MethodObjectHash,
+			[]testedClientOp{func(c *Client) error {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, nil, anyValidOpts)
+				return err
+			}}, []testedClientOp{func(c *Client) error {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, nil, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+				return err
+			}, func(c *Client) error {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, []object.Range{newObjectRange(0, 0)}, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+				return err
+			}, func(c *Client) error {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, 0, nil, anyValidSigner, anyValidOpts)
+				return err
+			}}, func(c *Client) error {
+				_, err := c.ObjectHash(ctx, anyCID, anyOID, anyValidRanges, checksum.SHA256, nil, anyValidSigner, anyValidOpts)
+				return err
+			},
+		)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
@@ -106,6 +107,7 @@ type DefaultObjectWriter struct {
 
 	statisticCallback shortStatisticCallback
 	startTime         time.Time // if statisticCallback is set only
+	span              streamSpan
 
 	payloadSizeFromHeader uint64
 
@@ -250,6 +252,7 @@ func (x *DefaultObjectWriter) Write(chunk []byte) (n int, err error) {
 		}
 
 		writtenBytes += len(chunk[:ln])
+		x.span.addPayload(ln)
 		chunk = chunk[ln:]
 	}
 
@@ -344,6 +347,7 @@ func (x *DefaultObjectWriter) ReadFrom(r io.Reader) (int64, error) {
 			}
 
 			writtenBytes += int64(actualRead)
+			x.span.addPayload(actualRead)
 		}
 
 		if err != nil {
@@ -396,6 +400,7 @@ func (x *DefaultObjectWriter) Close() error {
 			x.statisticCallback(time.Since(x.startTime), x.err)
 		}()
 	}
+	defer func() { x.span.end(x.err) }()
 
 	if x.bufCleanCallback != nil {
 		defer x.bufCleanCallback()
@@ -477,6 +482,10 @@ func (c *Client) ObjectPutInit(ctx context.Context, hdr object.Object, signer us
 			c.sendStatistic(stat.MethodObjectPut, time.Since(startTime), err)
 		}()
 	}
+
+	ctx, span := c.startSpan(ctx, stat.MethodObjectPut, telemetry.ContainerID(hdr.GetContainerID()))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
 	var w DefaultObjectWriter
 	if c.prm.statisticCallback != nil {
 		w.startTime = time.Now()
@@ -514,6 +523,7 @@ func (c *Client) ObjectPutInit(ctx context.Context, hdr object.Object, signer us
 	w.singleMsgTimeout = c.streamTimeout
 	w.opts = prm
 	w.payloadSizeFromHeader = hdr.PayloadSize()
+	w.span = c.startStreamSpan(ctx, stat.MethodObjectPutStream, telemetry.ContainerID(hdr.GetContainerID()))
 	if err = w.writeHeader(hdr); err != nil {
 		_ = w.Close()
 		err = fmt.Errorf("header write: %w", err)
@@ -13,6 +13,7 @@ import (
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	igrpc "github.com/nspcc-dev/neofs-sdk-go/internal/grpc"
 	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	protoacl "github.com/nspcc-dev/neofs-sdk-go/proto/acl"
@@ -109,6 +110,10 @@ func (c *Client) SearchObjects(ctx context.Context, cnr cid.ID, filters object.S
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectSearchV2, telemetry.ContainerID(cnr))
+	defer func() { telemetry.End(span, err) }()
+	opts.xHeaders = c.traceXHeaders(ctx, opts.xHeaders)
+
 	switch {
 	case signer == nil:
 		return nil, "", ErrMissingSigner
@@ -369,6 +374,7 @@ type ObjectListReader struct {
 
 	statisticCallback shortStatisticCallback
 	startTime         time.Time // if statisticCallback is set only
+	span              streamSpan
 }
 
 // Read reads another list of the object identifiers. Works similar to
@@ -469,6 +475,7 @@ func (x *ObjectListReader) Close() error {
 			x.statisticCallback(time.Since(x.startTime), err)
 		}()
 	}
+	defer func() { x.span.end(err) }()
 
 	defer x.cancelCtxStream()
 
@@ -502,6 +509,10 @@ func (c *Client) ObjectSearchInit(ctx context.Context, containerID cid.ID, signe
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodObjectSearch, telemetry.ContainerID(containerID))
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return nil, ErrMissingSigner
 	}
@@ -561,6 +572,7 @@ func (c *Client) ObjectSearchInit(ctx context.Context, containerID cid.ID, signe
 			c.sendStatistic(stat.MethodObjectSearchStream, dur, err)
 		}
 	}
+	r.span = c.startStreamSpan(ctx, stat.MethodObjectSearchStream, telemetry.ContainerID(containerID))
 
 	return &r, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	protoreputation "github.com/nspcc-dev/neofs-sdk-go/proto/reputation"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
 	"github.com/nspcc-dev/neofs-sdk-go/reputation"
@@ -40,6 +41,10 @@ func (c *Client) AnnounceLocalTrust(ctx context.Context, epoch uint64, trusts []
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodAnnounceLocalTrust)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	// check parameters
 	switch {
 	case epoch == 0:
@@ -118,6 +123,10 @@ func (c *Client) AnnounceIntermediateTrust(ctx context.Context, epoch uint64, tr
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodAnnounceIntermediateTrust)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if epoch == 0 {
 		err = ErrZeroEpoch
 		return err
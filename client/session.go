@@ -7,6 +7,7 @@ import (
 
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
 	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -79,6 +80,10 @@ func (c *Client) SessionCreate(ctx context.Context, signer user.Signer, prm PrmS
 		}()
 	}
 
+	ctx, span := c.startSpan(ctx, stat.MethodSessionCreate)
+	defer func() { telemetry.End(span, err) }()
+	prm.xHeaders = c.traceXHeaders(ctx, prm.xHeaders)
+
 	if signer == nil {
 		return nil, ErrMissingSigner
 	}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts span of the Client operation. Span is non-recording if
+// tracing is disabled. The span must be finished using [telemetry.End].
+func (c *Client) startSpan(ctx context.Context, m stat.Method, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if c.tracer.Enabled() {
+		attrs = append(attrs, telemetry.Endpoint(c.endpoint))
+	}
+	return c.tracer.Start(ctx, m, attrs...)
+}
+
+// traceXHeaders returns X-headers propagating trace context from ctx to the
+// server if tracing is enabled. Otherwise, xHeaders are returned as is.
+func (c *Client) traceXHeaders(ctx context.Context, xHeaders []string) []string {
+	if !c.tracer.Enabled() {
+		return xHeaders
+	}
+	return telemetry.InjectXHeaders(ctx, xHeaders)
+}
+
+// streamSpan traces object stream counting transmitted payload bytes. The
+// bytes are also reported to the payload callback if any. Zero streamSpan is
+// disabled.
+type streamSpan struct {
+	span            trace.Span
+	payload         uint64
+	payloadCallback func(size uint64)
+}
+
+// startStreamSpan starts span of the object stream if tracing is enabled.
+// Payload of the object streams is reported if payload callback is set.
+func (c *Client) startStreamSpan(ctx context.Context, m stat.Method, attrs ...attribute.KeyValue) streamSpan {
+	var res streamSpan
+	if c.prm.payloadCallback != nil && m != stat.MethodObjectSearchStream {
+		res.payloadCallback = func(size uint64) {
+			c.prm.payloadCallback(c.nodeKey, c.endpoint, m, size)
+		}
+	}
+	if c.tracer.Enabled() {
+		_, res.span = c.startSpan(ctx, m, attrs...)
+	}
+	return res
+}
+
+func (x *streamSpan) addPayload(n int) {
+	if n > 0 {
+		x.payload += uint64(n)
+	}
+}
+
+// end finishes the span with the stream result and reports transmitted
+// payload. Repeated calls are no-op.
+func (x *streamSpan) end(err error) {
+	if x.payloadCallback != nil {
+		x.payloadCallback(x.payload)
+		x.payloadCallback = nil
+	}
+	if x.span == nil {
+		return
+	}
+	x.span.SetAttributes(telemetry.PayloadSize(x.payload))
+	telemetry.End(x.span, err)
+	x.span = nil
+}
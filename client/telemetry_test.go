@@ -0,0 +1,230 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// xHeadersRecorder records X-headers of the unary requests received by the
+// server.
+type xHeadersRecorder struct {
+	mtx sync.Mutex
+	m   map[string][]string
+}
+
+func (x *xHeadersRecorder) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+	if r, ok := req.(interface {
+		GetMetaHeader() *protosession.RequestMetaHeader
+	}); ok {
+		var hs []string
+		for _, h := range r.GetMetaHeader().GetXHeaders() {
+			hs = append(hs, h.Key, h.Value)
+		}
+		x.mtx.Lock()
+		x.m[info.FullMethod] = hs
+		x.mtx.Unlock()
+	}
+	return h(ctx, req)
+}
+
+func (x *xHeadersRecorder) get(method string) []string {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	return x.m[method]
+}
+
+func newTracedClient(t *testing.T, tp trace.TracerProvider) (*neofstest.Node, *Client, *xHeadersRecorder) {
+	return newNodeClient(t, func(prm *PrmInit) { prm.SetTracerProvider(tp) })
+}
+
+func newNodeClient(t *testing.T, setPrm func(*PrmInit)) (*neofstest.Node, *Client, *xHeadersRecorder) {
+	node := neofstest.NewNode(neofscryptotest.Signer())
+	rec := &xHeadersRecorder{m: make(map[string][]string)}
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(rec.intercept))
+	node.Register(srv)
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	var prmInit PrmInit
+	setPrm(&prmInit)
+	c, err := New(prmInit)
+	require.NoError(t, err)
+
+	var prm PrmDial
+	prm.SetServerURI("grpc://localhost:8080")
+	prm.SetDialFunc(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) })
+	require.NoError(t, c.Dial(prm))
+	t.Cleanup(func() { _ = c.Close() })
+	return node, c, rec
+}
+
+func spanAttributes(s sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	res := make(map[attribute.Key]attribute.Value)
+	for _, a := range s.Attributes() {
+		res[a.Key] = a.Value
+	}
+	return res
+}
+
+func TestClient_Tracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	node, c, rec := newTracedClient(t, tp)
+	usr := usertest.User()
+	payload := []byte("Hello, world!")
+	addr := putTestObject(t, node, usr, payload)
+
+	ctx, root := tp.Tracer("test").Start(t.Context(), "root")
+
+	var prmHead PrmObjectHead
+	prmHead.WithXHeaders("k", "v")
+	_, err := c.ObjectHead(ctx, addr.Container(), addr.Object(), usr, prmHead)
+	require.NoError(t, err)
+
+	spans := sr.Ended()
+	span := spans[len(spans)-1]
+	require.Equal(t, "client.objectHead", span.Name())
+	require.Equal(t, trace.SpanKindClient, span.SpanKind())
+	require.Equal(t, root.SpanContext().SpanID(), span.Parent().SpanID())
+	require.Equal(t, map[attribute.Key]attribute.Value{
+		telemetry.AttributeContainerID: attribute.StringValue(addr.Container().EncodeToString()),
+		telemetry.AttributeObjectID:    attribute.StringValue(addr.Object().EncodeToString()),
+		telemetry.AttributeEndpoint:    attribute.StringValue("grpc://localhost:8080"),
+		telemetry.AttributeStatusCode:  attribute.Int64Value(0),
+	}, spanAttributes(span))
+
+	xHeaders := rec.get(protoobject.ObjectService_Head_FullMethodName)
+	require.Len(t, xHeaders, 4)
+	require.Equal(t, []string{"k", "v"}, xHeaders[:2])
+	remote := trace.SpanContextFromContext(telemetry.ExtractXHeaders(context.Background(), xHeaders))
+	require.Equal(t, span.SpanContext().TraceID(), remote.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), remote.SpanID())
+
+	t.Run("status", func(t *testing.T) {
+		_, err := c.ObjectHead(ctx, addr.Container(), oidtest.ID(), usr, PrmObjectHead{})
+		require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+
+		spans := sr.Ended()
+		span := spans[len(spans)-1]
+		require.Equal(t, codes.Error, span.Status().Code)
+		require.Equal(t, attribute.Int64Value(2049), spanAttributes(span)[telemetry.AttributeStatusCode])
+	})
+
+	t.Run("streams", func(t *testing.T) {
+		_, r, err := c.ObjectGetInit(ctx, addr.Container(), addr.Object(), usr, PrmObjectGet{})
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, payload, b)
+		require.NoError(t, r.Close())
+
+		var hdr object.Object
+		hdr.SetContainerID(addr.Container())
+		hdr.SetOwner(usr.ID)
+		w, err := c.ObjectPutInit(ctx, hdr, usr, PrmObjectPutInit{})
+		require.NoError(t, err)
+		_, err = w.Write(payload[:5])
+		require.NoError(t, err)
+		_, err = w.Write(payload[5:])
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		spans := sr.Ended()
+		byName := make(map[string]sdktrace.ReadOnlySpan)
+		for _, s := range spans {
+			byName[s.Name()] = s
+		}
+		for _, name := range []string{"client.objectGetStream", "client.objectPutStream"} {
+			s, ok := byName[name]
+			require.True(t, ok, name)
+			attrs := spanAttributes(s)
+			require.Equal(t, attribute.Int64Value(int64(len(payload))), attrs[telemetry.AttributePayloadSize], name)
+			require.Equal(t, attribute.Int64Value(0), attrs[telemetry.AttributeStatusCode], name)
+		}
+		require.Equal(t, byName["client.objectGet"].SpanContext().SpanID(), byName["client.objectGetStream"].Parent().SpanID())
+		require.Equal(t, byName["client.objectPut"].SpanContext().SpanID(), byName["client.objectPutStream"].Parent().SpanID())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		node, c, rec := newTracedClient(t, nil)
+		addr := putTestObject(t, node, usr, payload)
+
+		_, err := c.ObjectHead(ctx, addr.Container(), addr.Object(), usr, PrmObjectHead{})
+		require.NoError(t, err)
+		require.Empty(t, rec.get(protoobject.ObjectService_Head_FullMethodName))
+	})
+}
+
+func TestClient_PayloadCallback(t *testing.T) {
+	type collectedItem struct {
+		pub  []byte
+		mtd  stat.Method
+		size uint64
+	}
+	var collected []collectedItem
+	node, c, _ := newNodeClient(t, func(prm *PrmInit) {
+		prm.SetPayloadCallback(func(pub []byte, endpoint string, mtd stat.Method, size uint64) {
+			require.Equal(t, "grpc://localhost:8080", endpoint)
+			collected = append(collected, collectedItem{pub: pub, mtd: mtd, size: size})
+		})
+	})
+	usr := usertest.User()
+	payload := []byte("Hello, world!")
+	addr := putTestObject(t, node, usr, payload)
+
+	_, r, err := c.ObjectGetInit(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectGet{})
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.NoError(t, r.Close())
+
+	rr, err := c.ObjectRangeInit(t.Context(), addr.Container(), addr.Object(), 1, 5, usr, PrmObjectRange{})
+	require.NoError(t, err)
+	_, err = io.ReadAll(rr)
+	require.NoError(t, err)
+	require.NoError(t, rr.Close())
+
+	var hdr object.Object
+	hdr.SetContainerID(addr.Container())
+	hdr.SetOwner(usr.ID)
+	w, err := c.ObjectPutInit(t.Context(), hdr, usr, PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write(payload[:3])
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = c.ObjectHead(t.Context(), addr.Container(), addr.Object(), usr, PrmObjectHead{})
+	require.NoError(t, err)
+
+	pub := node.NodeInfo().PublicKey()
+	require.Equal(t, []collectedItem{
+		{pub: pub, mtd: stat.MethodObjectGetStream, size: uint64(len(payload))},
+		{pub: pub, mtd: stat.MethodObjectRangeStream, size: 5},
+		{pub: pub, mtd: stat.MethodObjectPutStream, size: 3},
+	}, collected)
+}
@@ -0,0 +1,128 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
+)
+
+// SizeEstimation groups information about estimation of the size of the data
+// stored in the NeoFS container.
+//
+// SizeEstimation is mutually compatible with
+// [protocontainer.AnnounceUsedSpaceRequest_Body_Announcement] message. See
+// [SizeEstimation.FromProtoMessage] / [SizeEstimation.ProtoMessage] methods.
+//
+// Instances can be created using built-in var declaration.
+type SizeEstimation struct {
+	epoch uint64
+	cnr   cid.ID
+	value uint64
+}
+
+// reads SizeEstimation from the container.AnnounceUsedSpaceRequest_Body_Announcement
+// message. If checkFieldPresence is set, returns an error on absence of any
+// protocol-required field.
+func (x *SizeEstimation) fromProtoMessage(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement, checkFieldPresence bool) error {
+	if m.ContainerId != nil {
+		if err := x.cnr.FromProtoMessage(m.ContainerId); err != nil {
+			return fmt.Errorf("invalid container: %w", err)
+		}
+	} else if checkFieldPresence {
+		return errors.New("missing container")
+	} else {
+		x.cnr = cid.ID{}
+	}
+
+	x.epoch = m.Epoch
+	x.value = m.UsedSpace
+
+	return nil
+}
+
+// FromProtoMessage validates m according to the NeoFS API protocol and restores
+// x from it.
+//
+// See also [SizeEstimation.ProtoMessage].
+func (x *SizeEstimation) FromProtoMessage(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement) error {
+	return x.fromProtoMessage(m, true)
+}
+
+// ProtoMessage converts x into message to transmit using the NeoFS API
+// protocol.
+//
+// See also [SizeEstimation.FromProtoMessage].
+func (x SizeEstimation) ProtoMessage() *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement {
+	m := &protocontainer.AnnounceUsedSpaceRequest_Body_Announcement{
+		Epoch:     x.epoch,
+		UsedSpace: x.value,
+	}
+	if !x.cnr.IsZero() {
+		m.ContainerId = x.cnr.ProtoMessage()
+	}
+	return m
+}
+
+// Marshal encodes SizeEstimation into a binary format of the NeoFS API protocol
+// (Protocol Buffers with direct field order).
+//
+// See also Unmarshal.
+func (x SizeEstimation) Marshal() []byte {
+	return neofsproto.Marshal(x)
+}
+
+// Unmarshal decodes NeoFS API protocol binary format into the SizeEstimation
+// (Protocol Buffers with direct field order). Returns an error describing
+// a format violation.
+//
+// See also Marshal.
+func (x *SizeEstimation) Unmarshal(data []byte) error {
+	return neofsproto.UnmarshalOptional(data, x, (*SizeEstimation).fromProtoMessage)
+}
+
+// SetEpoch sets epoch when estimation of the container data size was calculated.
+//
+// See also Epoch.
+func (x *SizeEstimation) SetEpoch(epoch uint64) {
+	x.epoch = epoch
+}
+
+// Epoch return epoch set using SetEpoch.
+//
+// Zero SizeEstimation represents estimation in zero epoch.
+func (x SizeEstimation) Epoch() uint64 {
+	return x.epoch
+}
+
+// SetContainer specifies the container for which the amount of data is
+// estimated. Required by the NeoFS API protocol.
+//
+// See also Container.
+func (x *SizeEstimation) SetContainer(cnr cid.ID) {
+	x.cnr = cnr
+}
+
+// Container returns container set using SetContainer.
+//
+// Zero SizeEstimation is not bound to any container (returns zero) which is
+// incorrect according to NeoFS API protocol.
+func (x SizeEstimation) Container() cid.ID {
+	return x.cnr
+}
+
+// SetValue sets estimated amount of data (in bytes) in the specified container.
+//
+// See also Value.
+func (x *SizeEstimation) SetValue(value uint64) {
+	x.value = value
+}
+
+// Value returns data size estimation set using SetValue.
+//
+// Zero SizeEstimation has zero value.
+func (x SizeEstimation) Value() uint64 {
+	return x.value
+}
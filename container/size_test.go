@@ -0,0 +1,159 @@
+package container_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	anyValidEstimationEpoch = 1937201843
+	anyValidEstimationValue = 8293730984921749582
+)
+
+var anyValidEstimationContainer = cid.ID{245, 188, 86, 80, 170, 97, 147, 48, 75, 27, 115, 238, 61, 151, 182, 191, 95, 33,
+	160, 138, 45, 5, 172, 150, 179, 187, 163, 43, 112, 178, 176, 223}
+
+// set by init.
+var validSizeEstimation container.SizeEstimation
+
+func init() {
+	validSizeEstimation.SetEpoch(anyValidEstimationEpoch)
+	validSizeEstimation.SetContainer(anyValidEstimationContainer)
+	validSizeEstimation.SetValue(anyValidEstimationValue)
+}
+
+var validBinSizeEstimation = []byte{8, 179, 181, 221, 155, 7, 18, 34, 10, 32, 245, 188, 86, 80, 170, 97, 147, 48, 75, 27, 115,
+	238, 61, 151, 182, 191, 95, 33, 160, 138, 45, 5, 172, 150, 179, 187, 163, 43, 112, 178, 176, 223, 24, 206, 176, 165, 151,
+	184, 140, 208, 140, 115}
+
+func TestSizeEstimation_SetEpoch(t *testing.T) {
+	var x container.SizeEstimation
+	require.Zero(t, x.Epoch())
+
+	val := rand.Uint64()
+	x.SetEpoch(val)
+	require.EqualValues(t, val, x.Epoch())
+	x.SetEpoch(val + 1)
+	require.EqualValues(t, val+1, x.Epoch())
+}
+
+func TestSizeEstimation_SetContainer(t *testing.T) {
+	var x container.SizeEstimation
+	require.Zero(t, x.Container())
+
+	cnr := cidtest.ID()
+	x.SetContainer(cnr)
+	require.Equal(t, cnr, x.Container())
+	cnrOther := cidtest.OtherID(cnr)
+	x.SetContainer(cnrOther)
+	require.Equal(t, cnrOther, x.Container())
+}
+
+func TestSizeEstimation_SetValue(t *testing.T) {
+	var x container.SizeEstimation
+	require.Zero(t, x.Value())
+
+	val := rand.Uint64()
+	x.SetValue(val)
+	require.EqualValues(t, val, x.Value())
+	x.SetValue(val + 1)
+	require.EqualValues(t, val+1, x.Value())
+}
+
+func TestSizeEstimation_FromProtoMessage(t *testing.T) {
+	m := &protocontainer.AnnounceUsedSpaceRequest_Body_Announcement{
+		Epoch:       anyValidEstimationEpoch,
+		ContainerId: &refs.ContainerID{Value: anyValidEstimationContainer[:]},
+		UsedSpace:   anyValidEstimationValue,
+	}
+
+	var x container.SizeEstimation
+	require.NoError(t, x.FromProtoMessage(m))
+	require.EqualValues(t, anyValidEstimationEpoch, x.Epoch())
+	require.Equal(t, anyValidEstimationContainer, x.Container())
+	require.EqualValues(t, anyValidEstimationValue, x.Value())
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name, err string
+			corrupt   func(*protocontainer.AnnounceUsedSpaceRequest_Body_Announcement)
+		}{
+			{name: "container/missing", err: "missing container",
+				corrupt: func(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement) { m.ContainerId = nil }},
+			{name: "container/nil value", err: "invalid container: invalid length 0",
+				corrupt: func(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement) {
+					m.ContainerId = new(refs.ContainerID)
+				}},
+			{name: "container/wrong length", err: "invalid container: invalid length 31",
+				corrupt: func(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement) {
+					m.ContainerId.Value = make([]byte, 31)
+				}},
+			{name: "container/zero", err: "invalid container: zero container ID",
+				corrupt: func(m *protocontainer.AnnounceUsedSpaceRequest_Body_Announcement) {
+					m.ContainerId.Value = make([]byte, 32)
+				}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				m := validSizeEstimation.ProtoMessage()
+				tc.corrupt(m)
+				require.EqualError(t, new(container.SizeEstimation).FromProtoMessage(m), tc.err)
+			})
+		}
+	})
+}
+
+func TestSizeEstimation_ProtoMessage(t *testing.T) {
+	var x container.SizeEstimation
+
+	// zero
+	m := x.ProtoMessage()
+	require.Zero(t, m.GetEpoch())
+	require.Nil(t, m.GetContainerId())
+	require.Zero(t, m.GetUsedSpace())
+
+	// filled
+	m = validSizeEstimation.ProtoMessage()
+	require.EqualValues(t, anyValidEstimationEpoch, m.GetEpoch())
+	require.Equal(t, anyValidEstimationContainer[:], m.GetContainerId().GetValue())
+	require.EqualValues(t, anyValidEstimationValue, m.GetUsedSpace())
+}
+
+func TestSizeEstimation_Marshal(t *testing.T) {
+	require.Equal(t, validBinSizeEstimation, validSizeEstimation.Marshal())
+}
+
+func TestSizeEstimation_Unmarshal(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("protobuf", func(t *testing.T) {
+			err := new(container.SizeEstimation).Unmarshal([]byte("Hello, world!"))
+			require.ErrorContains(t, err, "proto")
+			require.ErrorContains(t, err, "cannot parse invalid wire-format data")
+		})
+		t.Run("container", func(t *testing.T) {
+			err := new(container.SizeEstimation).Unmarshal([]byte{18, 2, 10, 0})
+			require.EqualError(t, err, "invalid container: invalid length 0")
+		})
+	})
+
+	var x container.SizeEstimation
+	// zero
+	require.NoError(t, x.Unmarshal(nil))
+	require.Zero(t, x)
+
+	// filled
+	require.NoError(t, x.Unmarshal(validBinSizeEstimation))
+	require.Equal(t, validSizeEstimation, x)
+
+	// random
+	rnd := containertest.SizeEstimation()
+	require.NoError(t, x.Unmarshal(rnd.Marshal()))
+	require.Equal(t, rnd, x)
+}
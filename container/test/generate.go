@@ -5,6 +5,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	netmaptest "github.com/nspcc-dev/neofs-sdk-go/netmap/test"
 	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 )
@@ -27,3 +28,12 @@ func BasicACL() (x acl.Basic) {
 	x.FromBits(rand.Uint32())
 	return
 }
+
+// SizeEstimation returns random container.SizeEstimation.
+func SizeEstimation() (x container.SizeEstimation) {
+	x.SetEpoch(rand.Uint64())
+	x.SetContainer(cidtest.ID())
+	x.SetValue(rand.Uint64())
+
+	return x
+}
@@ -0,0 +1,566 @@
+package eacl
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// Keywords of the eACL text representation.
+const (
+	textKeywordVersion   = "VERSION"
+	textKeywordContainer = "CONTAINER"
+	textKeywordWhere     = "WHERE"
+	textKeywordAnd       = "AND"
+	textKeywordTo        = "TO"
+	textKeywordComment   = "COMMENT"
+	textKeywordAccounts  = "ACCOUNTS"
+)
+
+// Filter header type prefixes of the eACL text representation.
+const (
+	textHeaderRequest = "req"
+	textHeaderObject  = "obj"
+	textHeaderService = "service"
+)
+
+var textMatchers = []struct {
+	m Match
+	s string
+}{
+	{MatchStringEqual, "=="},
+	{MatchStringNotEqual, "!="},
+	{MatchNumGT, ">"},
+	{MatchNumGE, ">="},
+	{MatchNumLT, "<"},
+	{MatchNumLE, "<="},
+}
+
+// WriteStringTo encodes Table into human-readable text and writes the result
+// into w. Returns w's errors directly.
+//
+// The text consists of lines. The first ones declare the version and the
+// container (if any) of the Table:
+//
+//	VERSION v2.17
+//	CONTAINER 5AUVbUQ4uHSZTdaB1ZKxQQC1mSgPTtkTUVbqsJZqkaNK
+//
+// followed by one line per [Record] in the following format:
+//
+//	ACTION OPERATION [WHERE FILTER [AND FILTER]...] [TO TARGET[, TARGET]...] [COMMENT "text"]
+//
+// Actions, operations and roles are written as their String representations,
+// e.g.
+//
+//	DENY GET WHERE obj:$Object:ownerID != NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM TO OTHERS
+//	ALLOW PUT TO USER, ACCOUNTS(NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM)
+//
+// Filter header types are written as 'req', 'obj' and 'service' prefixes of
+// the attribute key. Matchers are written as '==', '!=', '>', '>=', '<', '<='
+// and NOT_PRESENT. Keys and values that are not plain words are double-quoted
+// using Go syntax.
+//
+// See also [Table.DecodeString].
+func (t Table) WriteStringTo(w io.StringWriter) error {
+	_, err := w.WriteString(textKeywordVersion + " " + t.version.String())
+	if err != nil {
+		return err
+	}
+
+	if !t.cid.IsZero() {
+		_, err = w.WriteString("\n" + textKeywordContainer + " " + t.cid.EncodeToString())
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range t.records {
+		_, err = w.WriteString("\n" + t.records[i].textString())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r Record) textString() string {
+	var sb strings.Builder
+
+	sb.WriteString(r.action.String())
+	sb.WriteByte(' ')
+	sb.WriteString(r.operation.String())
+
+	for i := range r.filters {
+		if i == 0 {
+			sb.WriteString(" " + textKeywordWhere + " ")
+		} else {
+			sb.WriteString(" " + textKeywordAnd + " ")
+		}
+		sb.WriteString(r.filters[i].textString())
+	}
+
+	for i := range r.targets {
+		if i == 0 {
+			sb.WriteString(" " + textKeywordTo + " ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(r.targets[i].textString())
+	}
+
+	if r.comment != "" {
+		sb.WriteString(" " + textKeywordComment + " ")
+		sb.WriteString(strconv.Quote(r.comment))
+	}
+
+	return sb.String()
+}
+
+func (f Filter) textString() string {
+	var sb strings.Builder
+
+	switch f.from {
+	case HeaderFromRequest:
+		sb.WriteString(textHeaderRequest)
+	case HeaderFromObject:
+		sb.WriteString(textHeaderObject)
+	case HeaderFromService:
+		sb.WriteString(textHeaderService)
+	default:
+		sb.WriteString(strconv.FormatInt(int64(f.from), 10))
+	}
+	sb.WriteByte(':')
+	sb.WriteString(textWord(f.key))
+	sb.WriteByte(' ')
+
+	m := f.matcher.String()
+	for i := range textMatchers {
+		if textMatchers[i].m == f.matcher {
+			m = textMatchers[i].s
+			break
+		}
+	}
+	sb.WriteString(m)
+
+	v := f.Value()
+	if f.matcher != MatchNotPresent || v != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(textWord(v))
+	}
+
+	return sb.String()
+}
+
+func (t Target) textString() string {
+	if len(t.subjs) == 0 {
+		return t.role.String()
+	}
+
+	var sb strings.Builder
+	if t.role == RoleUnspecified {
+		sb.WriteString(textKeywordAccounts)
+	} else {
+		sb.WriteString(t.role.String())
+	}
+
+	sb.WriteByte('(')
+	for i := range t.subjs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(textSubject(t.subjs[i]))
+	}
+	sb.WriteByte(')')
+
+	return sb.String()
+}
+
+// textSubject returns text representation of the target subject: base58 for
+// valid user IDs and 0x-prefixed hex for any other data.
+func textSubject(b []byte) string {
+	if len(b) == user.IDSize {
+		s := user.ID(b).EncodeToString()
+		if _, err := user.DecodeString(s); err == nil {
+			return s
+		}
+	}
+	return "0x" + hex.EncodeToString(b)
+}
+
+func isTextWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.IndexByte("_$:./+-", c) >= 0
+}
+
+func isTextKeyword(s string) bool {
+	switch strings.ToUpper(s) {
+	case textKeywordWhere, textKeywordAnd, textKeywordTo, textKeywordComment:
+		return true
+	}
+	return false
+}
+
+// textWord returns s as is if it is a plain word, and quoted s otherwise.
+func textWord(s string) string {
+	if s == "" || isTextKeyword(s) {
+		return strconv.Quote(s)
+	}
+	for i := range len(s) {
+		if !isTextWordChar(s[i]) {
+			return strconv.Quote(s)
+		}
+	}
+	return s
+}
+
+// DecodeString decodes Table from the string composed using
+// [Table.WriteStringTo]. Returns error if s is malformed.
+//
+// Keywords, roles, actions, operations and header type prefixes are
+// case-insensitive. Empty lines and lines starting with '#' are ignored. If
+// version is not specified, [version.Current] is used.
+func (t *Table) DecodeString(s string) error {
+	res := Table{version: version.Current()}
+	var verSet, cnrSet bool
+
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		toks, err := textTokenize(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch strings.ToUpper(toks[0].s) {
+		case textKeywordVersion:
+			if verSet {
+				return fmt.Errorf("line %d: duplicated version", i+1)
+			} else if len(res.records) > 0 {
+				return fmt.Errorf("line %d: version after records", i+1)
+			} else if len(toks) != 2 || toks[1].kind != textTokenWord {
+				return fmt.Errorf("line %d: invalid version: expected single value", i+1)
+			} else if err = res.version.DecodeString(toks[1].s); err != nil {
+				return fmt.Errorf("line %d: invalid version: %w", i+1, err)
+			}
+			verSet = true
+		case textKeywordContainer:
+			if cnrSet {
+				return fmt.Errorf("line %d: duplicated container", i+1)
+			} else if len(res.records) > 0 {
+				return fmt.Errorf("line %d: container after records", i+1)
+			} else if len(toks) != 2 || toks[1].kind != textTokenWord {
+				return fmt.Errorf("line %d: invalid container: expected single value", i+1)
+			} else if err = res.cid.DecodeString(toks[1].s); err != nil {
+				return fmt.Errorf("line %d: invalid container: %w", i+1, err)
+			}
+			cnrSet = true
+		default:
+			var r Record
+			if err = r.decodeText(toks); err != nil {
+				return fmt.Errorf("line %d: %w", i+1, err)
+			}
+			res.records = append(res.records, r)
+		}
+	}
+
+	*t = res
+
+	return nil
+}
+
+type textTokenKind uint8
+
+const (
+	textTokenWord textTokenKind = iota
+	textTokenString
+	textTokenPunct
+)
+
+type textToken struct {
+	kind textTokenKind
+	s    string
+}
+
+func textTokenize(s string) ([]textToken, error) {
+	var res []textToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case isTextWordChar(c):
+			j := i + 1
+			for j < len(s) && isTextWordChar(s[j]) {
+				j++
+			}
+			res = append(res, textToken{kind: textTokenWord, s: s[i:j]})
+			i = j
+		case c == '"':
+			q, err := strconv.QuotedPrefix(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted string at position %d", i+1)
+			}
+			v, _ := strconv.Unquote(q) // cannot fail after QuotedPrefix
+			res = append(res, textToken{kind: textTokenString, s: v})
+			i += len(q)
+		case c == '(' || c == ')' || c == ',':
+			res = append(res, textToken{kind: textTokenPunct, s: s[i : i+1]})
+			i++
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			j := i + 1
+			if j < len(s) && s[j] == '=' {
+				j++
+			}
+			res = append(res, textToken{kind: textTokenPunct, s: s[i:j]})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i+1)
+		}
+	}
+	return res, nil
+}
+
+// textParser is a cursor over the tokens of a single record line.
+type textParser struct {
+	toks []textToken
+	pos  int
+}
+
+func (p *textParser) done() bool { return p.pos >= len(p.toks) }
+
+func (p *textParser) peekKeyword(kw string) bool {
+	return !p.done() && p.toks[p.pos].kind == textTokenWord && strings.EqualFold(p.toks[p.pos].s, kw)
+}
+
+func (p *textParser) peekPunct(s string) bool {
+	return !p.done() && p.toks[p.pos].kind == textTokenPunct && p.toks[p.pos].s == s
+}
+
+func (p *textParser) next(what string) (textToken, error) {
+	if p.done() {
+		return textToken{}, fmt.Errorf("missing %s", what)
+	}
+	p.pos++
+	return p.toks[p.pos-1], nil
+}
+
+// value reads a plain word or a quoted string.
+func (p *textParser) value(what string) (string, error) {
+	tok, err := p.next(what)
+	if err != nil {
+		return "", err
+	}
+	if tok.kind == textTokenPunct || tok.kind == textTokenWord && isTextKeyword(tok.s) {
+		return "", fmt.Errorf("unexpected %q instead of %s", tok.s, what)
+	}
+	return tok.s, nil
+}
+
+func (r *Record) decodeText(toks []textToken) error {
+	p := textParser{toks: toks}
+
+	tok, err := p.next("action")
+	if err != nil {
+		return err
+	}
+	if tok.kind != textTokenWord || !r.action.DecodeString(strings.ToUpper(tok.s)) {
+		return fmt.Errorf("invalid action %q", tok.s)
+	}
+
+	tok, err = p.next("operation")
+	if err != nil {
+		return err
+	}
+	if tok.kind != textTokenWord || !r.operation.DecodeString(strings.ToUpper(tok.s)) {
+		return fmt.Errorf("invalid operation %q", tok.s)
+	}
+
+	if p.peekKeyword(textKeywordWhere) {
+		p.pos++
+		for {
+			var f Filter
+			if err = f.decodeText(&p); err != nil {
+				return fmt.Errorf("invalid filter #%d: %w", len(r.filters), err)
+			}
+			r.filters = append(r.filters, f)
+			if !p.peekKeyword(textKeywordAnd) {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	if p.peekKeyword(textKeywordTo) {
+		p.pos++
+		for {
+			var t Target
+			if err = t.decodeText(&p); err != nil {
+				return fmt.Errorf("invalid target #%d: %w", len(r.targets), err)
+			}
+			r.targets = append(r.targets, t)
+			if !p.peekPunct(",") {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	if p.peekKeyword(textKeywordComment) {
+		p.pos++
+		tok, err = p.next("comment")
+		if err != nil {
+			return err
+		}
+		if tok.kind != textTokenString {
+			return errors.New("comment must be a quoted string")
+		}
+		if err = r.SetComment(tok.s); err != nil {
+			return err
+		}
+	}
+
+	if !p.done() {
+		return fmt.Errorf("unexpected %q", p.toks[p.pos].s)
+	}
+
+	return nil
+}
+
+func (f *Filter) decodeText(p *textParser) error {
+	tok, err := p.next("header type")
+	if err != nil {
+		return err
+	}
+	if tok.kind != textTokenWord {
+		return fmt.Errorf("unexpected %q instead of header type", tok.s)
+	}
+
+	from, key, ok := strings.Cut(tok.s, ":")
+	if !ok {
+		return fmt.Errorf("missing header type in %q", tok.s)
+	}
+	switch strings.ToLower(from) {
+	case textHeaderRequest:
+		f.from = HeaderFromRequest
+	case textHeaderObject:
+		f.from = HeaderFromObject
+	case textHeaderService:
+		f.from = HeaderFromService
+	default:
+		n, err := strconv.ParseInt(from, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid header type %q", from)
+		}
+		f.from = FilterHeaderType(n)
+	}
+
+	if key == "" {
+		// key is quoted
+		if f.key, err = p.value("key"); err != nil {
+			return err
+		}
+	} else {
+		f.key = key
+	}
+
+	tok, err = p.next("matcher")
+	if err != nil {
+		return err
+	}
+	ok = false
+	if tok.kind == textTokenPunct {
+		for i := range textMatchers {
+			if textMatchers[i].s == tok.s {
+				f.matcher, ok = textMatchers[i].m, true
+				break
+			}
+		}
+	} else if tok.kind == textTokenWord {
+		ok = f.matcher.DecodeString(strings.ToUpper(tok.s))
+	}
+	if !ok {
+		return fmt.Errorf("invalid matcher %q", tok.s)
+	}
+
+	var v string
+	if f.matcher != MatchNotPresent || !p.done() && !p.peekKeyword(textKeywordAnd) &&
+		!p.peekKeyword(textKeywordTo) && !p.peekKeyword(textKeywordComment) {
+		if v, err = p.value("value"); err != nil {
+			return err
+		}
+	}
+	f.value = staticStringer(v)
+
+	return nil
+}
+
+func (t *Target) decodeText(p *textParser) error {
+	tok, err := p.next("role")
+	if err != nil {
+		return err
+	}
+	if tok.kind != textTokenWord {
+		return fmt.Errorf("unexpected %q instead of role", tok.s)
+	}
+
+	withSubjs := p.peekPunct("(")
+	if !strings.EqualFold(tok.s, textKeywordAccounts) {
+		if !t.role.DecodeString(strings.ToUpper(tok.s)) {
+			return fmt.Errorf("invalid role %q", tok.s)
+		}
+	} else if !withSubjs {
+		return errors.New("missing accounts")
+	}
+	if !withSubjs {
+		return nil
+	}
+	p.pos++
+
+	t.subjs = [][]byte{}
+	for !p.peekPunct(")") {
+		if len(t.subjs) > 0 {
+			if !p.peekPunct(",") {
+				if p.done() {
+					return errors.New("missing closing parenthesis")
+				}
+				return fmt.Errorf("unexpected %q instead of comma", p.toks[p.pos].s)
+			}
+			p.pos++
+		}
+
+		tok, err = p.next("subject")
+		if err != nil {
+			return err
+		}
+		if tok.kind != textTokenWord {
+			return fmt.Errorf("unexpected %q instead of subject", tok.s)
+		}
+
+		var b []byte
+		if h, ok := strings.CutPrefix(tok.s, "0x"); ok {
+			if b, err = hex.DecodeString(h); err != nil {
+				return fmt.Errorf("invalid subject #%d: %w", len(t.subjs), err)
+			}
+		} else {
+			usr, err := user.DecodeString(tok.s)
+			if err != nil {
+				return fmt.Errorf("invalid subject #%d: %w", len(t.subjs), err)
+			}
+			b = usr[:]
+		}
+		t.subjs = append(t.subjs, b)
+	}
+	p.pos++
+
+	return nil
+}
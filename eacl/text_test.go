@@ -0,0 +1,181 @@
+package eacl_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	eacltest "github.com/nspcc-dev/neofs-sdk-go/eacl/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+// corresponds to anyValidEACL.
+const anyValidEACLText = `VERSION v2.16
+CONTAINER HRK1fwY2PMS4mgy292eZeDBr5XyH6mHbXzx7ds3n81vz
+5692342 12943052 WHERE 4509681:key_54093643 949385 val_34811040 TO 690857412
+43658603 12943052 WHERE 4509681:key_54093643 949385 val_34811040 AND 582984:key_1298432 7539428 val_8243258 TO 690857412, ACCOUNTS(0x03cad98e62d1bebc917bae15adefeff54394cd773adfdbd1dc71d786e465f922da, NX2upbPkxN9hbDnMTwnJUcGh16SDFEzmZE, 0x02952b32c45bb13e83e97ef1b10d4e605e774737b30835f14f02015f554e2dc588, NiMQRhrtZw76WXPfx4kJ7TFF459sEa11fT, NawXk76nYS35V4v1AxzDHFGVN2eqtuFZMD)`
+
+type failStringWriter struct{ n int }
+
+func (x *failStringWriter) WriteString(string) (int, error) {
+	if x.n == 0 {
+		return 0, errors.New("any error")
+	}
+	x.n--
+	return 0, nil
+}
+
+func TestTable_WriteStringTo(t *testing.T) {
+	var sb strings.Builder
+	require.NoError(t, anyValidEACL.WriteStringTo(&sb))
+	require.Equal(t, anyValidEACLText, sb.String())
+
+	t.Run("writer failure", func(t *testing.T) {
+		for n := range 4 {
+			require.EqualError(t, anyValidEACL.WriteStringTo(&failStringWriter{n: n}), "any error")
+		}
+	})
+
+	usr, err := user.DecodeString("NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM")
+	require.NoError(t, err)
+
+	r1 := eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationGet, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)},
+		eacl.NewFilterObjectOwnerEquals(usr))
+	r2 := eacl.ConstructRecord(eacl.ActionAllow, eacl.OperationPut,
+		[]eacl.Target{eacl.NewTargetByRole(eacl.RoleUser), eacl.NewTargetByAccounts([]user.ID{usr})},
+		eacl.NewRequestHeaderFilter("X-Header", eacl.MatchNotPresent, ""),
+		eacl.NewCustomServiceFilter("my key", eacl.MatchNumGE, "10"),
+		eacl.NewObjectPropertyFilter("and", eacl.MatchNumLT, ""),
+	)
+	require.NoError(t, r2.SetComment("any \"comment\""))
+	r3 := eacl.ConstructRecord(eacl.ActionUnspecified, eacl.OperationRangeHash, nil,
+		eacl.ConstructFilter(eacl.HeaderTypeUnspecified, "k", eacl.MatchUnspecified, "v"))
+
+	tbl := eacl.ConstructTable([]eacl.Record{r1, r2, r3})
+	tbl.SetVersion(version.New(2, 17))
+
+	sb.Reset()
+	require.NoError(t, tbl.WriteStringTo(&sb))
+	require.Equal(t, `VERSION v2.17
+DENY GET WHERE obj:$Object:ownerID == NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM TO OTHERS
+ALLOW PUT WHERE req:X-Header NOT_PRESENT AND service:"my key" >= 10 AND obj:"and" < "" TO USER, ACCOUNTS(NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM) COMMENT "any \"comment\""
+ACTION_UNSPECIFIED GETRANGEHASH WHERE 0:k MATCH_TYPE_UNSPECIFIED v`, sb.String())
+
+	var res eacl.Table
+	require.NoError(t, res.DecodeString(sb.String()))
+	require.Equal(t, tbl, res)
+}
+
+func TestTable_DecodeString(t *testing.T) {
+	var tbl eacl.Table
+	require.NoError(t, tbl.DecodeString(anyValidEACLText))
+	require.Equal(t, anyValidEACL, tbl)
+
+	t.Run("random", func(t *testing.T) {
+		for range 10 {
+			tbl := eacltest.Table()
+
+			var sb strings.Builder
+			require.NoError(t, tbl.WriteStringTo(&sb))
+
+			var res eacl.Table
+			require.NoError(t, res.DecodeString(sb.String()))
+			require.Equal(t, tbl.Marshal(), res.Marshal())
+		}
+	})
+
+	t.Run("relaxed", func(t *testing.T) {
+		usr, err := user.DecodeString("NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM")
+		require.NoError(t, err)
+
+		require.NoError(t, tbl.DecodeString(`
+# deny reading foreign objects
+deny get where obj:$Object:ownerID != NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM to others
+
+Allow Put Where Req:"X-Header" not_present To accounts(NbUgTSFvPmsRxmGeWpuuGeJUoRoi6PErcM,0x0102)
+`))
+		require.Equal(t, version.Current(), tbl.Version())
+		require.True(t, tbl.GetCID().IsZero())
+
+		tgt := eacl.NewTargetByAccounts([]user.ID{usr})
+		tgt.SetRawSubjects(append(tgt.RawSubjects(), []byte{1, 2}))
+		require.Equal(t, []eacl.Record{
+			eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationGet, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)},
+				eacl.NewObjectPropertyFilter(eacl.FilterObjectOwnerID, eacl.MatchStringNotEqual, usr.EncodeToString())),
+			eacl.ConstructRecord(eacl.ActionAllow, eacl.OperationPut, []eacl.Target{tgt},
+				eacl.NewRequestHeaderFilter("X-Header", eacl.MatchNotPresent, "")),
+		}, tbl.Records())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct{ name, err, s string }{
+			{name: "invalid character", err: "line 1: unexpected character '&' at position 10",
+				s: "DENY GET &"},
+			{name: "unclosed quote", err: "line 1: invalid quoted string at position 39",
+				s: `DENY GET WHERE obj:key == val COMMENT "text`},
+			{name: "version/duplicated", err: "line 2: duplicated version",
+				s: "VERSION v2.17\nVERSION v2.17"},
+			{name: "version/after records", err: "line 2: version after records",
+				s: "DENY GET\nVERSION v2.17"},
+			{name: "version/no value", err: "line 1: invalid version: expected single value",
+				s: "VERSION"},
+			{name: "version/invalid", err: "line 1: invalid version: doesn't start with 'v'",
+				s: "VERSION 2.17"},
+			{name: "container/duplicated", err: "line 2: duplicated container",
+				s: "CONTAINER HRK1fwY2PMS4mgy292eZeDBr5XyH6mHbXzx7ds3n81vz\nCONTAINER HRK1fwY2PMS4mgy292eZeDBr5XyH6mHbXzx7ds3n81vz"},
+			{name: "container/after records", err: "line 2: container after records",
+				s: "DENY GET\nCONTAINER HRK1fwY2PMS4mgy292eZeDBr5XyH6mHbXzx7ds3n81vz"},
+			{name: "container/invalid", err: "line 1: invalid container: invalid length 3",
+				s: "CONTAINER abc"},
+			{name: "action/invalid", err: `line 1: invalid action "PERMIT"`,
+				s: "PERMIT GET"},
+			{name: "operation/missing", err: "line 1: missing operation",
+				s: "DENY"},
+			{name: "operation/invalid", err: `line 1: invalid operation "READ"`,
+				s: "DENY READ"},
+			{name: "filter/missing", err: "line 1: invalid filter #0: missing header type",
+				s: "DENY GET WHERE"},
+			{name: "filter/no header type", err: `line 1: invalid filter #0: missing header type in "key"`,
+				s: "DENY GET WHERE key == val"},
+			{name: "filter/invalid header type", err: `line 1: invalid filter #0: invalid header type "attr"`,
+				s: "DENY GET WHERE attr:key == val"},
+			{name: "filter/missing key", err: "line 1: invalid filter #1: missing key",
+				s: "DENY GET WHERE obj:k == v AND obj:"},
+			{name: "filter/invalid matcher", err: `line 1: invalid filter #0: invalid matcher "="`,
+				s: "DENY GET WHERE obj:key = val"},
+			{name: "filter/missing value", err: "line 1: invalid filter #0: missing value",
+				s: "DENY GET WHERE obj:key =="},
+			{name: "filter/keyword value", err: `line 1: invalid filter #0: unexpected "TO" instead of value`,
+				s: "DENY GET WHERE obj:key == TO OTHERS"},
+			{name: "target/missing", err: "line 1: invalid target #0: missing role",
+				s: "DENY GET TO"},
+			{name: "target/invalid role", err: `line 1: invalid target #1: invalid role "ALL"`,
+				s: "DENY GET TO OTHERS, ALL"},
+			{name: "target/accounts/missing", err: "line 1: invalid target #0: missing accounts",
+				s: "DENY GET TO ACCOUNTS"},
+			{name: "target/accounts/unclosed", err: "line 1: invalid target #0: missing closing parenthesis",
+				s: "DENY GET TO ACCOUNTS(0x01"},
+			{name: "target/accounts/no comma", err: `line 1: invalid target #0: unexpected "0x02" instead of comma`,
+				s: "DENY GET TO ACCOUNTS(0x01 0x02)"},
+			{name: "target/accounts/invalid hex", err: "line 1: invalid target #0: invalid subject #0: encoding/hex: odd length hex string",
+				s: "DENY GET TO ACCOUNTS(0x012)"},
+			{name: "target/accounts/invalid user", err: "line 1: invalid target #0: invalid subject #1: decode base58: invalid base58 digit ('l')",
+				s: "DENY GET TO ACCOUNTS(0x01, l)"},
+			{name: "comment/missing", err: "line 1: missing comment",
+				s: "DENY GET COMMENT"},
+			{name: "comment/not quoted", err: "line 1: comment must be a quoted string",
+				s: "DENY GET COMMENT text"},
+			{name: "comment/zero byte", err: "line 1: comment contains zero byte",
+				s: `DENY GET COMMENT "\x00"`},
+			{name: "trailing data", err: `line 1: unexpected "OTHERS"`,
+				s: "DENY GET OTHERS"},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				require.EqualError(t, new(eacl.Table).DecodeString(tc.s), tc.err)
+			})
+		}
+	})
+}
@@ -32,7 +32,17 @@ func NewValidator() *Validator {
 // Note that if some rule imposes requirements on the format of values (like
 // numeric), but they do not comply with it - such a rule does not match.
 func (v *Validator) CalculateAction(unit *ValidationUnit) (Action, bool, error) {
-	for _, record := range unit.table.Records() {
+	action, _, final, err := v.CalculateActionRecord(unit)
+	return action, final, err
+}
+
+// CalculateActionRecord works like [Validator.CalculateAction] but also returns
+// index of the table record that produced the action. For non-final action,
+// the index refers to the record with filters that could not be processed
+// because of missing headers. Negative index means that no record matched and
+// the action is the default one.
+func (v *Validator) CalculateActionRecord(unit *ValidationUnit) (Action, int, bool, error) {
+	for i, record := range unit.table.Records() {
 		// check type of operation
 		if record.Operation() != unit.op {
 			continue
@@ -46,16 +56,16 @@ func (v *Validator) CalculateAction(unit *ValidationUnit) (Action, bool, error)
 		// check headers
 		switch val, err := matchFilters(unit.hdrSrc, record.Filters()); {
 		case err != nil:
-			return ActionDeny, false, err
+			return ActionDeny, i, false, err
 		case val < 0:
 			// headers of some type could not be composed => allow
-			return ActionAllow, false, nil
+			return ActionAllow, i, false, nil
 		case val == 0:
-			return record.Action(), true, nil
+			return record.Action(), i, true, nil
 		}
 	}
 
-	return ActionAllow, true, nil
+	return ActionAllow, -1, true, nil
 }
 
 // returns:
@@ -494,3 +494,50 @@ func TestAbsenceRules(t *testing.T) {
 	require.NoError(t, err)
 	require.Zero(t, v)
 }
+
+type unavailableHeaders struct{}
+
+func (unavailableHeaders) HeadersOfType(FilterHeaderType) ([]Header, bool, error) {
+	return nil, false, nil
+}
+
+func TestValidator_CalculateActionRecord(t *testing.T) {
+	tgt := NewTargetByRole(RoleOthers)
+	tb := ConstructTable([]Record{
+		ConstructRecord(ActionDeny, OperationGet, []Target{tgt}, NewObjectPropertyFilter("a", MatchStringEqual, "xxx")),
+		ConstructRecord(ActionDeny, OperationHead, []Target{tgt}),
+		ConstructRecord(ActionAllow, OperationGet, []Target{tgt}),
+	})
+
+	v := NewValidator()
+	vu := newValidationUnit(RoleOthers, nil, &tb).WithOperation(OperationGet)
+	hs := headers{}
+	vu.hdrSrc = &hs
+
+	action, i, ok, err := v.CalculateActionRecord(vu)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ActionAllow, action)
+	require.Equal(t, 2, i)
+
+	hs.obj = makeHeaders("a", "xxx")
+	action, i, ok, err = v.CalculateActionRecord(vu)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ActionDeny, action)
+	require.Zero(t, i)
+
+	vu.WithOperation(OperationPut)
+	action, i, ok, err = v.CalculateActionRecord(vu)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ActionAllow, action)
+	require.Negative(t, i)
+
+	vu.WithOperation(OperationGet).WithHeaderSource(unavailableHeaders{})
+	action, i, ok, err = v.CalculateActionRecord(vu)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, ActionAllow, action)
+	require.Zero(t, i)
+}
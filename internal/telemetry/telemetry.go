@@ -0,0 +1,146 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Attributes of the spans.
+const (
+	AttributeContainerID = attribute.Key("neofs.container.id")
+	AttributeObjectID    = attribute.Key("neofs.object.id")
+	AttributeEndpoint    = attribute.Key("neofs.node.endpoint")
+	AttributePayloadSize = attribute.Key("neofs.payload.size")
+	AttributeStatusCode  = attribute.Key("neofs.status.code")
+)
+
+// ContainerID returns span attribute with the given container ID.
+func ContainerID(id cid.ID) attribute.KeyValue {
+	return AttributeContainerID.String(id.EncodeToString())
+}
+
+// ObjectID returns span attribute with the given object ID.
+func ObjectID(id oid.ID) attribute.KeyValue {
+	return AttributeObjectID.String(id.EncodeToString())
+}
+
+// Endpoint returns span attribute with the given network endpoint.
+func Endpoint(endpoint string) attribute.KeyValue {
+	return AttributeEndpoint.String(endpoint)
+}
+
+// PayloadSize returns span attribute with the given number of payload bytes.
+func PayloadSize(n uint64) attribute.KeyValue {
+	return AttributePayloadSize.Int64(int64(n))
+}
+
+// Tracer creates spans of the SDK operations. Zero Tracer is disabled and
+// creates non-recording spans.
+type Tracer struct {
+	tracer trace.Tracer
+	prefix string
+	kind   trace.SpanKind
+}
+
+// NewTracer constructs Tracer with the given instrumentation scope name. Span
+// names are made of the prefix and operation name. Nil provider disables
+// tracing.
+func NewTracer(tp trace.TracerProvider, scope, prefix string, kind trace.SpanKind) Tracer {
+	if tp == nil {
+		return Tracer{}
+	}
+	return Tracer{tracer: tp.Tracer(scope), prefix: prefix, kind: kind}
+}
+
+// Enabled checks whether tracing is enabled.
+func (x Tracer) Enabled() bool {
+	return x.tracer != nil
+}
+
+// Start starts span of the operation. The span must be finished using [End].
+func (x Tracer) Start(ctx context.Context, m stat.Method, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if x.tracer == nil {
+		return ctx, noop.Span{}
+	}
+	return x.tracer.Start(ctx, x.prefix+m.String(), trace.WithSpanKind(x.kind), trace.WithAttributes(attrs...))
+}
+
+// End finishes the span with the operation result. NeoFS API status code is
+// set for successful operations and status errors.
+func End(span trace.Span, err error) {
+	if !span.IsRecording() {
+		span.End()
+		return
+	}
+	switch {
+	case err == nil:
+		span.SetAttributes(AttributeStatusCode.Int64(0))
+	case errors.Is(err, apistatus.Error):
+		span.SetAttributes(AttributeStatusCode.Int64(int64(apistatus.FromError(err).GetCode())))
+		fallthrough
+	default:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectXHeaders returns X-headers carrying W3C Trace Context of the span from
+// ctx in addition to the given ones. Trace Context headers already present in
+// xHeaders are overwritten. The original slice is not modified.
+func InjectXHeaders(ctx context.Context, xHeaders []string) []string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return xHeaders
+	}
+	c := xHeaderCarrier(append([]string(nil), xHeaders...))
+	propagation.TraceContext{}.Inject(ctx, &c)
+	return c
+}
+
+// ExtractXHeaders returns a copy of ctx with W3C Trace Context carried by the
+// given X-headers.
+func ExtractXHeaders(ctx context.Context, xHeaders []string) context.Context {
+	c := xHeaderCarrier(xHeaders)
+	return propagation.TraceContext{}.Extract(ctx, &c)
+}
+
+// xHeaderCarrier is a [propagation.TextMapCarrier] over X-headers key-value
+// list.
+type xHeaderCarrier []string
+
+func (x *xHeaderCarrier) Get(key string) string {
+	for i := 0; i+1 < len(*x); i += 2 {
+		if (*x)[i] == key {
+			return (*x)[i+1]
+		}
+	}
+	return ""
+}
+
+func (x *xHeaderCarrier) Set(key, value string) {
+	for i := 0; i+1 < len(*x); i += 2 {
+		if (*x)[i] == key {
+			(*x)[i+1] = value
+			return
+		}
+	}
+	*x = append(*x, key, value)
+}
+
+func (x *xHeaderCarrier) Keys() []string {
+	res := make([]string, 0, len(*x)/2)
+	for i := 0; i+1 < len(*x); i += 2 {
+		res = append(res, (*x)[i])
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTracer() (telemetry.Tracer, *tracetest.SpanRecorder) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	return telemetry.NewTracer(tp, "test", "test.", trace.SpanKindClient), sr
+}
+
+func attrValue(s sdktrace.ReadOnlySpan, k attribute.Key) (attribute.Value, bool) {
+	for _, a := range s.Attributes() {
+		if a.Key == k {
+			return a.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestTracer(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		var tr telemetry.Tracer
+		require.False(t, tr.Enabled())
+		ctx, span := tr.Start(t.Context(), stat.MethodObjectHead)
+		require.False(t, span.IsRecording())
+		require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+		telemetry.End(span, nil)
+
+		require.False(t, telemetry.NewTracer(nil, "test", "test.", trace.SpanKindClient).Enabled())
+	})
+
+	tr, sr := newTracer()
+	require.True(t, tr.Enabled())
+
+	_, span := tr.Start(t.Context(), stat.MethodObjectHead, telemetry.Endpoint("localhost:8080"))
+	telemetry.End(span, nil)
+	_, span = tr.Start(t.Context(), stat.MethodObjectGet)
+	telemetry.End(span, apistatus.ErrObjectNotFound)
+	_, span = tr.Start(t.Context(), stat.MethodObjectPut)
+	telemetry.End(span, errors.New("any error"))
+
+	spans := sr.Ended()
+	require.Len(t, spans, 3)
+
+	require.Equal(t, "test.objectHead", spans[0].Name())
+	require.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	v, ok := attrValue(spans[0], telemetry.AttributeEndpoint)
+	require.True(t, ok)
+	require.Equal(t, "localhost:8080", v.AsString())
+	v, ok = attrValue(spans[0], telemetry.AttributeStatusCode)
+	require.True(t, ok)
+	require.Zero(t, v.AsInt64())
+
+	require.Equal(t, "test.objectGet", spans[1].Name())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	v, ok = attrValue(spans[1], telemetry.AttributeStatusCode)
+	require.True(t, ok)
+	require.EqualValues(t, 2049, v.AsInt64())
+
+	require.Equal(t, "test.objectPut", spans[2].Name())
+	require.Equal(t, codes.Error, spans[2].Status().Code)
+	require.Equal(t, "any error", spans[2].Status().Description)
+	_, ok = attrValue(spans[2], telemetry.AttributeStatusCode)
+	require.False(t, ok)
+}
+
+func TestXHeaders(t *testing.T) {
+	xHeaders := []string{"k1", "v1", "k2", "v2"}
+
+	t.Run("no span", func(t *testing.T) {
+		require.Equal(t, xHeaders, telemetry.InjectXHeaders(t.Context(), xHeaders))
+	})
+
+	tr, _ := newTracer()
+	ctx, span := tr.Start(t.Context(), stat.MethodObjectHead)
+	defer span.End()
+
+	res := telemetry.InjectXHeaders(ctx, xHeaders)
+	require.Len(t, res, len(xHeaders)+2)
+	require.Equal(t, xHeaders, res[:len(xHeaders)])
+	require.Equal(t, "traceparent", res[len(xHeaders)])
+	require.Equal(t, []string{"k1", "v1", "k2", "v2"}, xHeaders)
+
+	got := trace.SpanContextFromContext(telemetry.ExtractXHeaders(context.Background(), res))
+	require.True(t, got.IsRemote())
+	require.Equal(t, span.SpanContext().TraceID(), got.TraceID())
+	require.Equal(t, span.SpanContext().SpanID(), got.SpanID())
+
+	t.Run("overwrite", func(t *testing.T) {
+		res := telemetry.InjectXHeaders(ctx, []string{"traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", "k", "v"})
+		require.Len(t, res, 4)
+		require.Equal(t, []string{"k", "v"}, res[2:])
+		got := trace.SpanContextFromContext(telemetry.ExtractXHeaders(context.Background(), res))
+		require.Equal(t, span.SpanContext().SpanID(), got.SpanID())
+	})
+}
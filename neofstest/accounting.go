@@ -0,0 +1,31 @@
+package neofstest
+
+import (
+	"context"
+
+	protoaccounting "github.com/nspcc-dev/neofs-sdk-go/proto/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+type accountingService struct {
+	protoaccounting.UnimplementedAccountingServiceServer
+	node *Node
+}
+
+func (s *accountingService) Balance(_ context.Context, req *protoaccounting.BalanceRequest) (*protoaccounting.BalanceResponse, error) {
+	body, meta := execute(s.node, req, func(body *protoaccounting.BalanceRequest_Body) (*protoaccounting.BalanceResponse_Body, error) {
+		if body.GetOwnerId() == nil {
+			return nil, badRequest("missing account")
+		}
+		var usr user.ID
+		if err := usr.FromProtoMessage(body.OwnerId); err != nil {
+			return nil, badRequest("invalid account: %v", err)
+		}
+
+		s.node.chain.mtx.RLock()
+		defer s.node.chain.mtx.RUnlock()
+		return &protoaccounting.BalanceResponse_Body{Balance: s.node.chain.balances[usr].ProtoMessage()}, nil
+	})
+	resp := &protoaccounting.BalanceResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
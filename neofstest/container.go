@@ -0,0 +1,330 @@
+package neofstest
+
+import (
+	"context"
+	"slices"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+type containerService struct {
+	protocontainer.UnimplementedContainerServiceServer
+	node *Node
+}
+
+// decodeSignature decodes RFC 6979 signature and checks it against data.
+func decodeSignature(m *refs.SignatureRFC6979, data []byte) (neofscrypto.Signature, error) {
+	if m == nil {
+		return neofscrypto.Signature{}, badRequest("missing signature")
+	}
+	sig := neofscrypto.NewSignatureFromRawKey(neofscrypto.ECDSA_DETERMINISTIC_SHA256, m.Key, m.Sign)
+	if !sig.Verify(data) {
+		var st apistatus.SignatureVerification
+		st.SetMessage("invalid signature")
+		return neofscrypto.Signature{}, st
+	}
+	return sig, nil
+}
+
+func signatureToProto(sig neofscrypto.Signature) *refs.SignatureRFC6979 {
+	return &refs.SignatureRFC6979{Key: sig.PublicKeyBytes(), Sign: sig.Value()}
+}
+
+func decodeContainerID(m *refs.ContainerID) (cid.ID, error) {
+	if m == nil {
+		return cid.ID{}, badRequest("missing container ID")
+	}
+	var id cid.ID
+	if err := id.FromProtoMessage(m); err != nil {
+		return cid.ID{}, badRequest("invalid container ID: %v", err)
+	}
+	return id, nil
+}
+
+// Container returns container stored in the Node.
+func (n *Node) Container(id cid.ID) (container.Container, bool) {
+	n.chain.mtx.RLock()
+	defer n.chain.mtx.RUnlock()
+	c, ok := n.chain.containers[id]
+	return c.container, ok
+}
+
+// PutContainer saves container in the Node bypassing the ContainerService and
+// returns its ID.
+func (n *Node) PutContainer(cnr container.Container) cid.ID {
+	id := cid.NewFromMarshalledContainer(cnr.Marshal())
+	n.chain.mtx.Lock()
+	n.chain.containers[id] = storedContainer{container: cnr}
+	n.chain.mtx.Unlock()
+	return id
+}
+
+// dropContainer removes all objects of the container from the Node.
+func (n *Node) dropContainer(cnr cid.ID) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	for addr := range n.objects {
+		if addr.Container() == cnr {
+			delete(n.objects, addr)
+		}
+	}
+	for addr := range n.splits {
+		if addr.Container() == cnr {
+			delete(n.splits, addr)
+		}
+	}
+}
+
+// container returns container stored in the sidechain.
+func (n *Node) container(id cid.ID) (container.Container, error) {
+	n.chain.mtx.RLock()
+	defer n.chain.mtx.RUnlock()
+	c, ok := n.chain.containers[id]
+	if !ok {
+		return container.Container{}, apistatus.ErrContainerNotFound
+	}
+	return c.container, nil
+}
+
+func (s *containerService) Put(_ context.Context, req *protocontainer.PutRequest) (*protocontainer.PutResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.PutRequest_Body) (*protocontainer.PutResponse_Body, error) {
+		if body.GetContainer() == nil {
+			return nil, badRequest("missing container")
+		}
+		var cnr container.Container
+		if err := cnr.FromProtoMessage(body.Container); err != nil {
+			return nil, badRequest("invalid container: %v", err)
+		}
+		b := cnr.Marshal()
+		sig, err := decodeSignature(body.Signature, b)
+		if err != nil {
+			return nil, err
+		}
+
+		stored := storedContainer{container: cnr, signature: sig}
+		id := cid.NewFromMarshalledContainer(b)
+
+		var eACL *storedEACL
+		if body.Eacl != nil {
+			var e storedEACL
+			if err = e.table.FromProtoMessage(body.Eacl); err != nil {
+				return nil, badRequest("invalid eACL: %v", err)
+			}
+			if e.signature, err = decodeSignature(body.EaclSignature, neofsproto.MarshalMessage(body.Eacl)); err != nil {
+				return nil, err
+			}
+			e.table.SetCID(id)
+			eACL = &e
+		}
+
+		s.node.chain.mtx.Lock()
+		defer s.node.chain.mtx.Unlock()
+		s.node.chain.containers[id] = stored
+		if eACL != nil {
+			s.node.chain.eACLs[id] = *eACL
+		}
+		return &protocontainer.PutResponse_Body{ContainerId: id.ProtoMessage()}, nil
+	})
+	resp := &protocontainer.PutResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) Delete(_ context.Context, req *protocontainer.DeleteRequest) (*protocontainer.DeleteResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.DeleteRequest_Body) (*protocontainer.DeleteResponse_Body, error) {
+		id, err := decodeContainerID(body.GetContainerId())
+		if err != nil {
+			return nil, err
+		}
+		if _, err = decodeSignature(body.Signature, id[:]); err != nil {
+			return nil, err
+		}
+
+		s.node.chain.mtx.Lock()
+		_, ok := s.node.chain.containers[id]
+		delete(s.node.chain.containers, id)
+		delete(s.node.chain.eACLs, id)
+		s.node.chain.mtx.Unlock()
+		if !ok {
+			return nil, apistatus.ErrContainerNotFound
+		}
+
+		for _, n := range s.node.networkNodes() {
+			n.dropContainer(id)
+		}
+		return &protocontainer.DeleteResponse_Body{}, nil
+	})
+	resp := &protocontainer.DeleteResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) Get(_ context.Context, req *protocontainer.GetRequest) (*protocontainer.GetResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.GetRequest_Body) (*protocontainer.GetResponse_Body, error) {
+		id, err := decodeContainerID(body.GetContainerId())
+		if err != nil {
+			return nil, err
+		}
+
+		s.node.chain.mtx.RLock()
+		defer s.node.chain.mtx.RUnlock()
+		c, ok := s.node.chain.containers[id]
+		if !ok {
+			return nil, apistatus.ErrContainerNotFound
+		}
+		return &protocontainer.GetResponse_Body{
+			Container: c.container.ProtoMessage(),
+			Signature: signatureToProto(c.signature),
+		}, nil
+	})
+	resp := &protocontainer.GetResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) List(_ context.Context, req *protocontainer.ListRequest) (*protocontainer.ListResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.ListRequest_Body) (*protocontainer.ListResponse_Body, error) {
+		var owner user.ID
+		if body.GetOwnerId() != nil {
+			if err := owner.FromProtoMessage(body.OwnerId); err != nil {
+				return nil, badRequest("invalid owner: %v", err)
+			}
+		}
+
+		s.node.chain.mtx.RLock()
+		ids := make([]cid.ID, 0, len(s.node.chain.containers))
+		for id, c := range s.node.chain.containers {
+			if owner.IsZero() || c.container.Owner() == owner {
+				ids = append(ids, id)
+			}
+		}
+		s.node.chain.mtx.RUnlock()
+
+		slices.SortFunc(ids, cid.ID.Compare)
+		res := &protocontainer.ListResponse_Body{ContainerIds: make([]*refs.ContainerID, len(ids))}
+		for i := range ids {
+			res.ContainerIds[i] = ids[i].ProtoMessage()
+		}
+		return res, nil
+	})
+	resp := &protocontainer.ListResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) SetExtendedACL(_ context.Context, req *protocontainer.SetExtendedACLRequest) (*protocontainer.SetExtendedACLResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.SetExtendedACLRequest_Body) (*protocontainer.SetExtendedACLResponse_Body, error) {
+		if body.GetEacl() == nil {
+			return nil, badRequest("missing eACL")
+		}
+		var e storedEACL
+		if err := e.table.FromProtoMessage(body.Eacl); err != nil {
+			return nil, badRequest("invalid eACL: %v", err)
+		}
+		id := e.table.GetCID()
+		if id.IsZero() {
+			return nil, badRequest("missing container ID in eACL")
+		}
+		var err error
+		if e.signature, err = decodeSignature(body.Signature, neofsproto.MarshalMessage(body.Eacl)); err != nil {
+			return nil, err
+		}
+
+		s.node.chain.mtx.Lock()
+		defer s.node.chain.mtx.Unlock()
+		if _, ok := s.node.chain.containers[id]; !ok {
+			return nil, apistatus.ErrContainerNotFound
+		}
+		s.node.chain.eACLs[id] = e
+		return &protocontainer.SetExtendedACLResponse_Body{}, nil
+	})
+	resp := &protocontainer.SetExtendedACLResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) GetExtendedACL(_ context.Context, req *protocontainer.GetExtendedACLRequest) (*protocontainer.GetExtendedACLResponse, error) {
+	body, meta := execute(s.node, req, func(body *protocontainer.GetExtendedACLRequest_Body) (*protocontainer.GetExtendedACLResponse_Body, error) {
+		id, err := decodeContainerID(body.GetContainerId())
+		if err != nil {
+			return nil, err
+		}
+
+		s.node.chain.mtx.RLock()
+		defer s.node.chain.mtx.RUnlock()
+		if _, ok := s.node.chain.containers[id]; !ok {
+			return nil, apistatus.ErrContainerNotFound
+		}
+		e, ok := s.node.chain.eACLs[id]
+		if !ok {
+			return nil, apistatus.ErrEACLNotFound
+		}
+		return &protocontainer.GetExtendedACLResponse_Body{
+			Eacl:      e.table.ProtoMessage(),
+			Signature: signatureToProto(e.signature),
+		}, nil
+	})
+	resp := &protocontainer.GetExtendedACLResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *containerService) AnnounceUsedSpace(_ context.Context, req *protocontainer.AnnounceUsedSpaceRequest) (*protocontainer.AnnounceUsedSpaceResponse, error) {
+	body, meta := execute(s.node, req, func(*protocontainer.AnnounceUsedSpaceRequest_Body) (*protocontainer.AnnounceUsedSpaceResponse_Body, error) {
+		return &protocontainer.AnnounceUsedSpaceResponse_Body{}, nil
+	})
+	resp := &protocontainer.AnnounceUsedSpaceResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+// updateContainer verifies body signature and applies f to the referenced
+// container.
+func (s *containerService) updateContainer(body neofscrypto.ProtoMessage, bodySig *refs.Signature, m *refs.ContainerID,
+	params neofscrypto.ProtoMessage, paramsSig *refs.SignatureRFC6979, f func(*container.Container)) error {
+	if bodySig == nil {
+		return badRequest("missing body signature")
+	}
+	if err := neofscrypto.VerifyMessageSignature(body, bodySig, nil); err != nil {
+		var st apistatus.SignatureVerification
+		st.SetMessage(err.Error())
+		return st
+	}
+	id, err := decodeContainerID(m)
+	if err != nil {
+		return err
+	}
+	if _, err = decodeSignature(paramsSig, neofsproto.MarshalMessage(params)); err != nil {
+		return err
+	}
+
+	s.node.chain.mtx.Lock()
+	defer s.node.chain.mtx.Unlock()
+	c, ok := s.node.chain.containers[id]
+	if !ok {
+		return apistatus.ErrContainerNotFound
+	}
+	f(&c.container)
+	s.node.chain.containers[id] = c
+	return nil
+}
+
+func (s *containerService) SetAttribute(_ context.Context, req *protocontainer.SetAttributeRequest) (*protocontainer.SetAttributeResponse, error) {
+	params := req.GetBody().GetParameters()
+	err := s.updateContainer(req.GetBody(), req.GetBodySignature(), params.GetContainerId(), params, req.GetBody().GetSignature(), func(c *container.Container) {
+		c.SetAttribute(params.Attribute, params.Value)
+	})
+	return &protocontainer.SetAttributeResponse{Status: apistatus.FromError(err)}, nil
+}
+
+func (s *containerService) RemoveAttribute(_ context.Context, req *protocontainer.RemoveAttributeRequest) (*protocontainer.RemoveAttributeResponse, error) {
+	params := req.GetBody().GetParameters()
+	err := s.updateContainer(req.GetBody(), req.GetBodySignature(), params.GetContainerId(), params, req.GetBody().GetSignature(), func(c *container.Container) {
+		m := c.ProtoMessage()
+		m.Attributes = slices.DeleteFunc(m.Attributes, func(a *protocontainer.Container_Attribute) bool {
+			return a.Key == params.Attribute
+		})
+		_ = c.FromProtoMessage(m)
+	})
+	return &protocontainer.RemoveAttributeResponse{Status: apistatus.FromError(err)}, nil
+}
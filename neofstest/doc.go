@@ -0,0 +1,41 @@
+/*
+Package neofstest provides in-memory NeoFS storage node for testing
+applications built on top of the SDK without a real network.
+
+[Node] serves object, container, netmap, accounting and session NeoFS API
+services over gRPC. It signs responses and reports failures using NeoFS API
+statuses like real nodes do, so [client.Client] and other SDK components work
+with it as usual. The simplest way to connect is the in-memory listener:
+
+	node := neofstest.NewNode(signer)
+	defer node.Stop()
+
+	var prm client.PrmDial
+	prm.SetServerURI("grpc://localhost:8080")
+	prm.SetDialFunc(node.Listen())
+
+	c, _ := client.New(client.PrmInit{})
+	err := c.Dial(prm)
+	// ...
+
+[Network] runs several nodes sharing the same network map and containers.
+Objects are placed on the nodes according to container storage policies, and
+requests are forwarded between nodes, so [pool.Pool] and multi-node scenarios
+including node failures can be tested:
+
+	network := neofstest.NewNetwork(4)
+	defer network.Stop()
+
+	var opts pool.InitParameters
+	opts.SetSigner(signer)
+	opts.SetDialFunc(network.Dial)
+	for _, endpoint := range network.Endpoints() {
+		opts.AddNode(pool.NewNodeParam(1, endpoint, 1))
+	}
+
+	p, _ := pool.NewPool(opts)
+	err := p.Dial(ctx)
+	// ...
+	network.Nodes()[0].SetDown(true)
+*/
+package neofstest
@@ -0,0 +1,62 @@
+package neofstest
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	protonetmap "github.com/nspcc-dev/neofs-sdk-go/proto/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+type netmapService struct {
+	protonetmap.UnimplementedNetmapServiceServer
+	node *Node
+}
+
+func (s *netmapService) LocalNodeInfo(_ context.Context, req *protonetmap.LocalNodeInfoRequest) (*protonetmap.LocalNodeInfoResponse, error) {
+	body, meta := execute(s.node, req, func(*protonetmap.LocalNodeInfoRequest_Body) (*protonetmap.LocalNodeInfoResponse_Body, error) {
+		return &protonetmap.LocalNodeInfoResponse_Body{
+			Version:  version.Current().ProtoMessage(),
+			NodeInfo: s.node.NodeInfo().ProtoMessage(),
+		}, nil
+	})
+	resp := &protonetmap.LocalNodeInfoResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *netmapService) NetworkInfo(_ context.Context, req *protonetmap.NetworkInfoRequest) (*protonetmap.NetworkInfoResponse, error) {
+	body, meta := execute(s.node, req, func(*protonetmap.NetworkInfoRequest_Body) (*protonetmap.NetworkInfoResponse_Body, error) {
+		s.node.chain.mtx.RLock()
+		defer s.node.chain.mtx.RUnlock()
+		return &protonetmap.NetworkInfoResponse_Body{NetworkInfo: s.node.chain.netInfo.ProtoMessage()}, nil
+	})
+	resp := &protonetmap.NetworkInfoResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *netmapService) NetmapSnapshot(_ context.Context, req *protonetmap.NetmapSnapshotRequest) (*protonetmap.NetmapSnapshotResponse, error) {
+	body, meta := execute(s.node, req, func(*protonetmap.NetmapSnapshotRequest_Body) (*protonetmap.NetmapSnapshotResponse_Body, error) {
+		return &protonetmap.NetmapSnapshotResponse_Body{Netmap: s.node.NetMap().ProtoMessage()}, nil
+	})
+	resp := &protonetmap.NetmapSnapshotResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+// NetMap returns network map announced via NetmapService.
+func (n *Node) NetMap() netmap.NetMap {
+	n.chain.mtx.RLock()
+	nm, epoch := n.chain.netMap, n.chain.netInfo.CurrentEpoch()
+	n.chain.mtx.RUnlock()
+	if nm != nil {
+		return *nm
+	}
+
+	var res netmap.NetMap
+	res.SetEpoch(epoch)
+	if n.network != nil {
+		res.SetNodes(n.network.nodeInfos())
+	} else {
+		res.SetNodes([]netmap.NodeInfo{n.NodeInfo()})
+	}
+	return res
+}
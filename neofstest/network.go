@@ -0,0 +1,159 @@
+package neofstest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Network is a set of in-memory [Node]s sharing the same NeoFS network state:
+// network map, containers, eACLs and balances. By default, all nodes are in the
+// network map.
+//
+// Objects are placed on container nodes according to the container storage
+// policy. Requests with TTL greater than 1 are forwarded to other container
+// nodes like real storage nodes do: objects are read from any of them, search
+// results are merged, deletion is applied on all container nodes. Nodes brought
+// down via [Node.SetDown] are skipped. Requests with TTL 1 are served locally.
+//
+// Network should be created using [NewNetwork].
+type Network struct {
+	nodes     []*Node
+	endpoints []string
+	dialers   map[string]func(context.Context, string) (net.Conn, error)
+}
+
+// NewNetwork constructs new Network of n nodes with random private keys and
+// starts serving them in memory. Nodes have grpc://node<i>.neofs:8080
+// endpoints, they can be dialed using [Network.Dial]. Network should be
+// stopped using [Network.Stop] after use.
+func NewNetwork(n int) *Network {
+	if n <= 0 {
+		panic(fmt.Sprintf("non-positive number of nodes %d", n))
+	}
+	chain := newSidechain()
+	res := &Network{
+		nodes:     make([]*Node, n),
+		endpoints: make([]string, n),
+		dialers:   make(map[string]func(context.Context, string) (net.Conn, error), n),
+	}
+	for i := range n {
+		host := fmt.Sprintf("node%d.neofs:8080", i)
+		res.endpoints[i] = "grpc://" + host
+		res.nodes[i] = newNode(neofscryptotest.Signer(), res.endpoints[i], chain, res)
+		res.dialers[host] = res.nodes[i].Listen()
+	}
+	return res
+}
+
+// Nodes returns all nodes of the Network.
+func (x *Network) Nodes() []*Node {
+	return slices.Clone(x.nodes)
+}
+
+// Endpoints returns network endpoints of all nodes of the Network.
+func (x *Network) Endpoints() []string {
+	return slices.Clone(x.endpoints)
+}
+
+// Dial connects to the Network node listening on the given address in
+// host:port format. Dial can be passed into [client.PrmDial.SetDialFunc] and
+// [pool.InitParameters.SetDialFunc].
+func (x *Network) Dial(ctx context.Context, addr string) (net.Conn, error) {
+	dial, ok := x.dialers[addr]
+	if !ok {
+		return nil, fmt.Errorf("unknown network address %q", addr)
+	}
+	return dial(ctx, addr)
+}
+
+// Stop stops all nodes of the Network.
+func (x *Network) Stop() {
+	for i := range x.nodes {
+		x.nodes[i].Stop()
+	}
+}
+
+func (x *Network) nodeInfos() []netmap.NodeInfo {
+	res := make([]netmap.NodeInfo, len(x.nodes))
+	for i := range x.nodes {
+		res[i] = x.nodes[i].NodeInfo()
+	}
+	return res
+}
+
+// node returns Network node with the given public key.
+func (x *Network) node(info netmap.NodeInfo) *Node {
+	for i := range x.nodes {
+		if slices.Equal(neofscrypto.PublicKeyBytes(x.nodes[i].signer.Public()), info.PublicKey()) {
+			return x.nodes[i]
+		}
+	}
+	return nil
+}
+
+// networkNodes returns all nodes of the network the Node belongs to. For
+// standalone nodes, the Node itself is returned.
+func (n *Node) networkNodes() []*Node {
+	if n.network == nil {
+		return []*Node{n}
+	}
+	return n.network.nodes
+}
+
+// containerNodes returns nodes of the container selected by its storage policy
+// from the current network map. For objects, nodes are sorted by placement
+// vectors. Nodes missing in the network are skipped.
+func (n *Node) containerNodes(cnr cid.ID, obj oid.ID) ([][]*Node, error) {
+	c, err := n.container(cnr)
+	if err != nil {
+		return nil, err
+	}
+	nm := n.NetMap()
+	vectors, err := nm.ContainerNodes(c.PlacementPolicy(), cnr)
+	if err != nil {
+		return nil, fmt.Errorf("select container nodes: %w", err)
+	}
+	if !obj.IsZero() {
+		if vectors, err = nm.PlacementVectors(vectors, obj); err != nil {
+			return nil, fmt.Errorf("sort container nodes: %w", err)
+		}
+	}
+	res := make([][]*Node, len(vectors))
+	for i := range vectors {
+		for j := range vectors[i] {
+			if node := n.network.node(vectors[i][j]); node != nil {
+				res[i] = append(res[i], node)
+			}
+		}
+	}
+	return res, nil
+}
+
+// objectNodes returns nodes processing the container objects: the Node itself
+// and, if fwd is set, other available container nodes.
+func (n *Node) objectNodes(cnr cid.ID, fwd bool) []*Node {
+	res := []*Node{n}
+	if !fwd || n.network == nil {
+		return res
+	}
+	vectors, err := n.containerNodes(cnr, oid.ID{})
+	if err != nil {
+		return res
+	}
+	for i := range vectors {
+		for _, node := range vectors[i] {
+			if !node.down.Load() && !slices.Contains(res, node) {
+				res = append(res, node)
+			}
+		}
+	}
+	return res
+}
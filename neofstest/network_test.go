@@ -0,0 +1,212 @@
+package neofstest_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object/relations"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/pool"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newNetwork(t *testing.T, n int) (*neofstest.Network, []*client.Client) {
+	network := neofstest.NewNetwork(n)
+	t.Cleanup(network.Stop)
+
+	cs := make([]*client.Client, n)
+	for i, endpoint := range network.Endpoints() {
+		var prm client.PrmDial
+		prm.SetServerURI(endpoint)
+		prm.SetDialFunc(network.Dial)
+
+		c, err := client.New(client.PrmInit{})
+		require.NoError(t, err)
+		require.NoError(t, c.Dial(prm))
+		t.Cleanup(func() { _ = c.Close() })
+		cs[i] = c
+	}
+	return network, cs
+}
+
+func newContainer(usr usertest.UserSigner, policy string) container.Container {
+	var p netmap.PlacementPolicy
+	if err := p.DecodeString(policy); err != nil {
+		panic(err)
+	}
+
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(usr.ID)
+	cnr.SetBasicACL(acl.PublicRW)
+	cnr.SetPlacementPolicy(p)
+	return cnr
+}
+
+// holders returns indices of the network nodes storing the object locally.
+func holders(network *neofstest.Network, cnr cid.ID, id oid.ID) []int {
+	var res []int
+	for i, node := range network.Nodes() {
+		if _, ok := node.Object(oid.NewAddress(cnr, id)); ok {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+func TestNetwork(t *testing.T) {
+	network, cs := newNetwork(t, 4)
+	usr := usertest.User()
+
+	nm, err := cs[0].NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+	require.NoError(t, err)
+	require.Len(t, nm.Nodes(), 4)
+
+	cnr, err := cs[0].ContainerPut(t.Context(), newContainer(usr, "REP 2"), usr.RFC6979, client.PrmContainerPut{})
+	require.NoError(t, err)
+	for i := range cs {
+		_, err = cs[i].ContainerGet(t.Context(), cnr, client.PrmContainerGet{})
+		require.NoError(t, err)
+	}
+
+	payload := []byte("Hello, world!")
+	id := putObject(t, cs[0], usr, cnr, payload)
+	stored := holders(network, cnr, id)
+	require.Len(t, stored, 2)
+
+	for i := range cs {
+		_, res := getObject(t, cs[i], usr, cnr, id)
+		require.Equal(t, payload, res)
+
+		var prm client.PrmObjectHead
+		prm.MarkLocal()
+		_, err = cs[i].ObjectHead(t.Context(), cnr, id, usr, prm)
+		if slices.Contains(stored, i) {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+		}
+	}
+
+	var fs object.SearchFilters
+	fs.AddRootFilter()
+	for i := range cs {
+		items, _, err := cs[i].SearchObjects(t.Context(), cnr, fs, nil, "", usr, client.SearchObjectsOptions{})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		require.Equal(t, id, items[0].ID)
+	}
+
+	_, err = cs[3].ObjectDelete(t.Context(), cnr, id, usr, client.PrmObjectDelete{})
+	require.NoError(t, err)
+	for i := range cs {
+		_, err = cs[i].ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+		require.ErrorIs(t, err, apistatus.ErrObjectAlreadyRemoved)
+	}
+}
+
+func TestNetwork_NodeFailure(t *testing.T) {
+	network, cs := newNetwork(t, 3)
+	nodes := network.Nodes()
+	usr := usertest.User()
+
+	cnr, err := cs[0].ContainerPut(t.Context(), newContainer(usr, "REP 2"), usr.RFC6979, client.PrmContainerPut{})
+	require.NoError(t, err)
+
+	id := putObject(t, cs[0], usr, cnr, []byte("payload"))
+	stored := holders(network, cnr, id)
+	require.Len(t, stored, 2)
+
+	nodes[stored[0]].SetDown(true)
+	_, err = cs[stored[0]].ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+	require.Error(t, err)
+	for i := range cs {
+		if i != stored[0] {
+			_, err = cs[i].ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+			require.NoError(t, err)
+		}
+	}
+
+	nodes[stored[1]].SetDown(true)
+	for i := range cs {
+		if i != stored[0] && i != stored[1] {
+			_, err = cs[i].ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+			require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+
+			id := putObject(t, cs[i], usr, cnr, []byte("other payload"))
+			require.Equal(t, []int{i}, holders(network, cnr, id))
+		}
+	}
+
+	nodes[stored[0]].SetDown(false)
+	nodes[stored[1]].SetDown(false)
+	for i := range cs {
+		_, err = cs[i].ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+		require.NoError(t, err)
+	}
+}
+
+func TestNetwork_Pool(t *testing.T) {
+	network := neofstest.NewNetwork(4)
+	t.Cleanup(network.Stop)
+	usr := usertest.User()
+
+	var opts pool.InitParameters
+	opts.SetSigner(usr.RFC6979)
+	opts.SetDialFunc(network.Dial)
+	opts.EnablePlacementRouting()
+	opts.SetRetryPolicy(pool.NewRetryPolicy(len(network.Nodes()), 0))
+	for _, endpoint := range network.Endpoints() {
+		opts.AddNode(pool.NewNodeParam(1, endpoint, 1))
+	}
+	p, err := pool.NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(t.Context()))
+	t.Cleanup(func() { _ = p.Close() })
+
+	cnr, err := p.ContainerPut(t.Context(), newContainer(usr, "REP 2"), usr.RFC6979, client.PrmContainerPut{})
+	require.NoError(t, err)
+
+	payload := make([]byte, 2*neofstest.DefaultMaxObjectSize+100)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+	s, err := slicer.New(t.Context(), p, usr, cnr, usr.ID, nil)
+	require.NoError(t, err)
+	id, err := s.Put(t.Context(), bytes.NewReader(payload), nil)
+	require.NoError(t, err)
+
+	_, link, err := relations.Get(t.Context(), p, cnr, id, relations.Tokens{}, usr)
+	require.NoError(t, err)
+	require.NotNil(t, link)
+	require.Len(t, holders(network, cnr, *link), 2)
+
+	_, b := getObject(t, p, usr, cnr, *link)
+	var l object.Link
+	require.NoError(t, l.Unmarshal(b))
+	require.Len(t, l.Objects(), 3)
+	for _, child := range l.Objects() {
+		require.Len(t, holders(network, cnr, child.ObjectID()), 2)
+	}
+
+	hdr, res := getObject(t, p, usr, cnr, id)
+	require.Equal(t, id, hdr.GetID())
+	require.Equal(t, payload, res)
+
+	network.Nodes()[holders(network, cnr, l.Objects()[0].ObjectID())[0]].SetDown(true)
+	_, err = p.ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+	require.NoError(t, err)
+	_, res = getObject(t, p, usr, cnr, id)
+	require.Equal(t, payload, res)
+}
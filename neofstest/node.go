@@ -0,0 +1,317 @@
+package neofstest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoaccounting "github.com/nspcc-dev/neofs-sdk-go/proto/accounting"
+	protocontainer "github.com/nspcc-dev/neofs-sdk-go/proto/container"
+	protonetmap "github.com/nspcc-dev/neofs-sdk-go/proto/netmap"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// DefaultMaxObjectSize is a maximum object size announced by the Node by
+	// default.
+	DefaultMaxObjectSize = 1 << 20
+	// DefaultMagicNumber is a network magic announced by the Node by default.
+	DefaultMagicNumber = 15405
+
+	listenerBufferSize = 1 << 20
+)
+
+// Node is an in-memory NeoFS storage node serving NeoFS API gRPC services:
+// object, container, netmap, accounting and session ones. All data is kept in
+// memory, so Node is intended for tests only.
+//
+// Node signs responses with its private key and verifies request signatures.
+// Failures are reported using NeoFS API statuses the same way real nodes do.
+// Access rules, bearer and session tokens are not checked.
+//
+// Node should be created using [NewNode] or [NewNetwork].
+type Node struct {
+	signer neofscrypto.Signer
+	// shared with other nodes of the network.
+	chain *sidechain
+	// nil for standalone nodes.
+	network *Network
+	down    atomic.Bool
+
+	srvMtx sync.Mutex
+	srv    *grpc.Server
+
+	mtx      sync.RWMutex
+	info     netmap.NodeInfo
+	sessions map[uuid.UUID]*keys.PrivateKey
+	objects  map[oid.Address]object.Object
+	removed  map[oid.Address]struct{}
+	locked   map[oid.Address]struct{}
+	// parents of split objects by addresses.
+	splits map[oid.Address]*splitRecord
+}
+
+// sidechain is a NeoFS network state shared by all nodes of the network.
+type sidechain struct {
+	mtx        sync.RWMutex
+	netInfo    netmap.NetworkInfo
+	netMap     *netmap.NetMap
+	balances   map[user.ID]accounting.Decimal
+	containers map[cid.ID]storedContainer
+	eACLs      map[cid.ID]storedEACL
+}
+
+type storedContainer struct {
+	container container.Container
+	signature neofscrypto.Signature
+}
+
+type storedEACL struct {
+	table     eacl.Table
+	signature neofscrypto.Signature
+}
+
+// splitRecord describes parent object stored as a split chain.
+type splitRecord struct {
+	header object.Object
+	first  oid.ID
+	last   oid.ID
+	link   oid.ID
+}
+
+func newSidechain() *sidechain {
+	var netInfo netmap.NetworkInfo
+	netInfo.SetCurrentEpoch(1)
+	netInfo.SetMagicNumber(DefaultMagicNumber)
+	netInfo.SetMaxObjectSize(DefaultMaxObjectSize)
+
+	return &sidechain{
+		netInfo:    netInfo,
+		balances:   make(map[user.ID]accounting.Decimal),
+		containers: make(map[cid.ID]storedContainer),
+		eACLs:      make(map[cid.ID]storedEACL),
+	}
+}
+
+// NewNode constructs new Node with the given private key. Initially, the Node
+// is in the network map alone, current epoch is 1. Network parameters are
+// [DefaultMagicNumber] and [DefaultMaxObjectSize].
+func NewNode(signer neofscrypto.Signer) *Node {
+	return newNode(signer, "grpc://localhost:8080", newSidechain(), nil)
+}
+
+func newNode(signer neofscrypto.Signer, endpoint string, chain *sidechain, network *Network) *Node {
+	var info netmap.NodeInfo
+	info.SetPublicKey(neofscrypto.PublicKeyBytes(signer.Public()))
+	info.SetNetworkEndpoints(endpoint)
+	info.SetOnline()
+
+	return &Node{
+		signer:   signer,
+		chain:    chain,
+		network:  network,
+		info:     info,
+		sessions: make(map[uuid.UUID]*keys.PrivateKey),
+		objects:  make(map[oid.Address]object.Object),
+		removed:  make(map[oid.Address]struct{}),
+		locked:   make(map[oid.Address]struct{}),
+		splits:   make(map[oid.Address]*splitRecord),
+	}
+}
+
+// NodeInfo returns information about the Node announced via NetmapService.
+func (n *Node) NodeInfo() netmap.NodeInfo {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+	return n.info
+}
+
+// SetNodeInfo sets information about the Node announced via NetmapService.
+// Public key must correspond to the Node private key.
+func (n *Node) SetNodeInfo(info netmap.NodeInfo) {
+	n.mtx.Lock()
+	n.info = info
+	n.mtx.Unlock()
+}
+
+// Epoch returns current NeoFS epoch.
+func (n *Node) Epoch() uint64 {
+	n.chain.mtx.RLock()
+	defer n.chain.mtx.RUnlock()
+	return n.chain.netInfo.CurrentEpoch()
+}
+
+// SetEpoch sets current NeoFS epoch. For nodes of the [Network], the epoch is
+// set for all of them.
+func (n *Node) SetEpoch(epoch uint64) {
+	n.chain.mtx.Lock()
+	n.chain.netInfo.SetCurrentEpoch(epoch)
+	n.chain.mtx.Unlock()
+}
+
+// SetNetworkInfo sets information about the NeoFS network announced via
+// NetmapService including the current epoch.
+func (n *Node) SetNetworkInfo(netInfo netmap.NetworkInfo) {
+	n.chain.mtx.Lock()
+	n.chain.netInfo = netInfo
+	n.chain.mtx.Unlock()
+}
+
+// SetNetMap sets network map announced via NetmapService. By default, network
+// map of the current epoch with all nodes of the network is announced.
+func (n *Node) SetNetMap(nm netmap.NetMap) {
+	n.chain.mtx.Lock()
+	n.chain.netMap = &nm
+	n.chain.mtx.Unlock()
+}
+
+// SetBalance sets balance of the given account.
+func (n *Node) SetBalance(usr user.ID, balance accounting.Decimal) {
+	n.chain.mtx.Lock()
+	n.chain.balances[usr] = balance
+	n.chain.mtx.Unlock()
+}
+
+// SetDown makes the Node unavailable: all requests to the services served by
+// [Node.Serve] and [Node.Listen] fail with gRPC Unavailable code, and other
+// nodes of the [Network] stop forwarding requests to the Node. SetDown(false)
+// brings the Node back.
+func (n *Node) SetDown(down bool) {
+	n.down.Store(down)
+}
+
+// Register registers Node services on the gRPC server.
+func (n *Node) Register(s *grpc.Server) {
+	protoaccounting.RegisterAccountingServiceServer(s, &accountingService{node: n})
+	protocontainer.RegisterContainerServiceServer(s, &containerService{node: n})
+	protonetmap.RegisterNetmapServiceServer(s, &netmapService{node: n})
+	protoobject.RegisterObjectServiceServer(s, &objectService{node: n})
+	protosession.RegisterSessionServiceServer(s, &sessionService{node: n})
+}
+
+// Serve accepts incoming connections on the listener serving Node services.
+// Serve returns when lis.Accept fails or [Node.Stop] is called. Node can be
+// served once only.
+func (n *Node) Serve(lis net.Listener) error {
+	n.srvMtx.Lock()
+	if n.srv != nil {
+		n.srvMtx.Unlock()
+		return errors.New("node is already served")
+	}
+	n.srv = grpc.NewServer(grpc.UnaryInterceptor(n.unaryInterceptor), grpc.StreamInterceptor(n.streamInterceptor))
+	n.Register(n.srv)
+	srv := n.srv
+	n.srvMtx.Unlock()
+
+	return srv.Serve(lis)
+}
+
+// Listen starts serving Node services over a new in-memory listener in
+// background. Returned function dials the listener ignoring the address, it
+// can be passed into [client.PrmDial.SetDialFunc]. Node is served until
+// [Node.Stop].
+func (n *Node) Listen() func(ctx context.Context, addr string) (net.Conn, error) {
+	lis := bufconn.Listen(listenerBufferSize)
+	go func() { _ = n.Serve(lis) }()
+	return func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+}
+
+// Stop stops serving Node services closing all connections.
+func (n *Node) Stop() {
+	n.srvMtx.Lock()
+	defer n.srvMtx.Unlock()
+	if n.srv != nil {
+		n.srv.Stop()
+	}
+}
+
+var errNodeDown = status.Error(codes.Unavailable, "node is down")
+
+func (n *Node) unaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+	if n.down.Load() {
+		return nil, errNodeDown
+	}
+	return h(ctx, req)
+}
+
+func (n *Node) streamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+	if n.down.Load() {
+		return errNodeDown
+	}
+	return h(srv, stream)
+}
+
+// responseMeta returns meta header of the response with status corresponding
+// to err.
+func (n *Node) responseMeta(err error) *protosession.ResponseMetaHeader {
+	return &protosession.ResponseMetaHeader{
+		Version: version.Current().ProtoMessage(),
+		Epoch:   n.Epoch(),
+		Status:  apistatus.FromError(err),
+	}
+}
+
+// verifyRequest checks request signatures.
+func verifyRequest[B neofscrypto.ProtoMessage](req neofscrypto.SignedRequest[B]) error {
+	if err := neofscrypto.VerifyRequestWithBuffer(req, nil); err != nil {
+		var st apistatus.SignatureVerification
+		st.SetMessage(err.Error())
+		return st
+	}
+	return nil
+}
+
+// forwarded checks whether the request may be forwarded to other nodes.
+func forwarded(mh *protosession.RequestMetaHeader) bool {
+	return mh.GetTtl() > 1
+}
+
+// execute verifies the request and executes op on its body. Returns response
+// body and meta header.
+func execute[RB neofscrypto.ProtoMessage, B any](n *Node, req neofscrypto.SignedRequest[RB], op func(RB) (B, error)) (B, *protosession.ResponseMetaHeader) {
+	var body B
+	err := verifyRequest(req)
+	if err == nil {
+		body, err = op(req.GetBody())
+	}
+	return body, n.responseMeta(err)
+}
+
+// signResponse signs the response and writes verification header into dst.
+func signResponse[B neofscrypto.ProtoMessage](n *Node, resp neofscrypto.SignedResponse[B], dst **protosession.ResponseVerificationHeader) error {
+	vh, err := neofscrypto.SignResponseWithBuffer(n.signer, resp, nil)
+	if err != nil {
+		return fmt.Errorf("sign response: %w", err)
+	}
+	*dst = vh
+	return nil
+}
+
+func badRequest(format string, args ...any) error {
+	var st apistatus.BadRequest
+	st.SetMessage(fmt.Sprintf(format, args...))
+	return st
+}
@@ -0,0 +1,367 @@
+package neofstest_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object/slicer"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func newNode(t *testing.T) (*neofstest.Node, *client.Client) {
+	node := neofstest.NewNode(neofscryptotest.Signer())
+	t.Cleanup(node.Stop)
+
+	var prm client.PrmDial
+	prm.SetServerURI("grpc://localhost:8080")
+	prm.SetDialFunc(node.Listen())
+
+	c, err := client.New(client.PrmInit{})
+	require.NoError(t, err)
+	require.NoError(t, c.Dial(prm))
+	t.Cleanup(func() { _ = c.Close() })
+
+	return node, c
+}
+
+func putContainer(t *testing.T, c *client.Client, usr usertest.UserSigner) cid.ID {
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 1"))
+
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(usr.ID)
+	cnr.SetBasicACL(acl.PublicRW)
+	cnr.SetPlacementPolicy(policy)
+	id, err := c.ContainerPut(t.Context(), cnr, usr.RFC6979, client.PrmContainerPut{})
+	require.NoError(t, err)
+	return id
+}
+
+func putObject(t *testing.T, c *client.Client, usr usertest.UserSigner, cnr cid.ID, payload []byte, attrs ...object.Attribute) oid.ID {
+	var obj object.Object
+	obj.SetContainerID(cnr)
+	obj.SetOwner(usr.ID)
+	obj.SetAttributes(attrs...)
+	obj.SetPayloadSize(uint64(len(payload)))
+	obj.SetPayload(payload)
+	require.NoError(t, obj.SetVerificationFields(usr))
+
+	w, err := c.ObjectPutInit(t.Context(), obj, usr, client.PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, obj.GetID(), w.GetResult().StoredObjectID())
+	return obj.GetID()
+}
+
+type objectGetter interface {
+	ObjectGetInit(context.Context, cid.ID, oid.ID, user.Signer, client.PrmObjectGet) (object.Object, *client.PayloadReader, error)
+}
+
+func getObject(t *testing.T, c objectGetter, usr usertest.UserSigner, cnr cid.ID, id oid.ID) (object.Object, []byte) {
+	hdr, r, err := c.ObjectGetInit(t.Context(), cnr, id, usr, client.PrmObjectGet{})
+	require.NoError(t, err)
+	payload, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	return hdr, payload
+}
+
+func tzSum(data []byte) []byte {
+	h := tz.Sum(data)
+	return h[:]
+}
+
+func TestNode_Netmap(t *testing.T) {
+	node, c := newNode(t)
+
+	node.SetEpoch(42)
+	usr := usertest.ID()
+	var balance accounting.Decimal
+	balance.SetValue(100)
+	balance.SetPrecision(8)
+	node.SetBalance(usr, balance)
+
+	info, err := c.EndpointInfo(t.Context(), client.PrmEndpointInfo{})
+	require.NoError(t, err)
+	require.Equal(t, version.Current(), info.LatestVersion())
+	require.Equal(t, node.NodeInfo().PublicKey(), info.NodeInfo().PublicKey())
+
+	ni, err := c.NetworkInfo(t.Context(), client.PrmNetworkInfo{})
+	require.NoError(t, err)
+	require.EqualValues(t, 42, ni.CurrentEpoch())
+	require.EqualValues(t, neofstest.DefaultMagicNumber, ni.MagicNumber())
+	require.EqualValues(t, neofstest.DefaultMaxObjectSize, ni.MaxObjectSize())
+
+	nm, err := c.NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+	require.NoError(t, err)
+	require.EqualValues(t, 42, nm.Epoch())
+	require.Len(t, nm.Nodes(), 1)
+	require.Equal(t, node.NodeInfo().PublicKey(), nm.Nodes()[0].PublicKey())
+
+	var prm client.PrmBalanceGet
+	prm.SetAccount(usr)
+	res, err := c.BalanceGet(t.Context(), prm)
+	require.NoError(t, err)
+	require.Equal(t, balance, res)
+
+	prm.SetAccount(usertest.OtherID(usr))
+	res, err = c.BalanceGet(t.Context(), prm)
+	require.NoError(t, err)
+	require.Zero(t, res.Value())
+
+	sess, err := c.SessionCreate(t.Context(), usertest.User(), client.PrmSessionCreate{})
+	require.NoError(t, err)
+	require.NotEmpty(t, sess.ID())
+	require.NotEmpty(t, sess.PublicKey())
+}
+
+func TestNode_Container(t *testing.T) {
+	_, c := newNode(t)
+	usr := usertest.User()
+
+	_, err := c.ContainerGet(t.Context(), cidtest.ID(), client.PrmContainerGet{})
+	require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+
+	id := putContainer(t, c, usr)
+	cnr, err := c.ContainerGet(t.Context(), id, client.PrmContainerGet{})
+	require.NoError(t, err)
+	require.Equal(t, usr.ID, cnr.Owner())
+	require.True(t, cnr.AssertID(id))
+
+	other := putContainer(t, c, usertest.User())
+	ids, err := c.ContainerList(t.Context(), usr.ID, client.PrmContainerList{})
+	require.NoError(t, err)
+	require.Equal(t, []cid.ID{id}, ids)
+
+	_, err = c.ContainerEACL(t.Context(), id, client.PrmContainerEACL{})
+	require.ErrorIs(t, err, apistatus.ErrEACLNotFound)
+
+	table := eacl.NewTableForContainer(id, []eacl.Record{
+		eacl.ConstructRecord(eacl.ActionDeny, eacl.OperationGet, []eacl.Target{eacl.NewTargetByRole(eacl.RoleOthers)}),
+	})
+	require.NoError(t, c.ContainerSetEACL(t.Context(), table, usr.RFC6979, client.PrmContainerSetEACL{}))
+	res, err := c.ContainerEACL(t.Context(), id, client.PrmContainerEACL{})
+	require.NoError(t, err)
+	require.Equal(t, table.Marshal(), res.Marshal())
+
+	require.NoError(t, c.ContainerDelete(t.Context(), id, usr.RFC6979, client.PrmContainerDelete{}))
+	_, err = c.ContainerGet(t.Context(), id, client.PrmContainerGet{})
+	require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+	err = c.ContainerDelete(t.Context(), id, usr.RFC6979, client.PrmContainerDelete{})
+	require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+
+	_, err = c.ContainerGet(t.Context(), other, client.PrmContainerGet{})
+	require.NoError(t, err)
+}
+
+func TestNode_Object(t *testing.T) {
+	_, c := newNode(t)
+	usr := usertest.User()
+	cnr := putContainer(t, c, usr)
+	payload := []byte("Hello, world!")
+
+	_, err := c.ObjectHead(t.Context(), cnr, oidtest.ID(), usr, client.PrmObjectHead{})
+	require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+	_, err = c.ObjectHead(t.Context(), cidtest.ID(), oidtest.ID(), usr, client.PrmObjectHead{})
+	require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+
+	id := putObject(t, c, usr, cnr, payload, object.NewAttribute("k", "v"))
+
+	hdr, res := getObject(t, c, usr, cnr, id)
+	require.Equal(t, id, hdr.GetID())
+	require.Equal(t, payload, res)
+	require.NoError(t, hdr.CheckHeaderVerificationFields())
+
+	h, err := c.ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+	require.NoError(t, err)
+	require.Equal(t, hdr.Attributes(), h.Attributes())
+
+	t.Run("range", func(t *testing.T) {
+		r, err := c.ObjectRangeInit(t.Context(), cnr, id, 7, 5, usr, client.PrmObjectRange{})
+		require.NoError(t, err)
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, payload[7:12], res)
+		require.NoError(t, r.Close())
+
+		r, err = c.ObjectRangeInit(t.Context(), cnr, id, 7, 100, usr, client.PrmObjectRange{})
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.ErrorIs(t, err, apistatus.ErrObjectOutOfRange)
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		rngs := make([]object.Range, 2)
+		rngs[0].SetLength(5)
+		rngs[1].SetOffset(7)
+		rngs[1].SetLength(6)
+		res, err := c.ObjectHash(t.Context(), cnr, id, rngs, checksum.SHA256, nil, usr, client.PrmObjectHash{})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Equal(t, checksum.NewSHA256(sha256.Sum256(payload[:5])), res[0])
+		require.Equal(t, checksum.NewSHA256(sha256.Sum256(payload[7:])), res[1])
+
+		res, err = c.ObjectHash(t.Context(), cnr, id, rngs[:1], checksum.TillichZemor, nil, usr, client.PrmObjectHash{})
+		require.NoError(t, err)
+		require.Equal(t, checksum.New(checksum.TillichZemor, tzSum(payload[:5])), res[0])
+	})
+
+	t.Run("search", func(t *testing.T) {
+		var ids []oid.ID
+		for i := range 5 {
+			ids = append(ids, putObject(t, c, usr, cnr, []byte{byte(i)}, object.NewAttribute("num", strconv.Itoa(10-i))))
+		}
+
+		var fs object.SearchFilters
+		fs.AddFilter("num", "7", object.MatchNumGE)
+		res, cursor, err := c.SearchObjects(t.Context(), cnr, fs, []string{"num"}, "", usr, client.SearchObjectsOptions{})
+		require.NoError(t, err)
+		require.Empty(t, cursor)
+		require.Len(t, res, 4)
+		for i := range res {
+			require.Equal(t, ids[3-i], res[i].ID)
+			require.Equal(t, []string{strconv.Itoa(7 + i)}, res[i].Attributes)
+		}
+
+		var opts client.SearchObjectsOptions
+		opts.SetCount(3)
+		res, cursor, err = c.SearchObjects(t.Context(), cnr, fs, []string{"num"}, "", usr, opts)
+		require.NoError(t, err)
+		require.NotEmpty(t, cursor)
+		require.Len(t, res, 3)
+		res, cursor, err = c.SearchObjects(t.Context(), cnr, fs, []string{"num"}, cursor, usr, opts)
+		require.NoError(t, err)
+		require.Empty(t, cursor)
+		require.Len(t, res, 1)
+		require.Equal(t, ids[0], res[0].ID)
+
+		fs = fs[:0]
+		fs.AddFilter("k", "v", object.MatchStringEqual)
+		var prm client.PrmObjectSearch
+		prm.SetFilters(fs)
+		r, err := c.ObjectSearchInit(t.Context(), cnr, usr, prm)
+		require.NoError(t, err)
+		var found []oid.ID
+		require.NoError(t, r.Iterate(func(id oid.ID) bool {
+			found = append(found, id)
+			return false
+		}))
+		require.Equal(t, []oid.ID{id}, found)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		id := putObject(t, c, usr, cnr, payload)
+		_, err := c.ObjectDelete(t.Context(), cnr, id, usr, client.PrmObjectDelete{})
+		require.NoError(t, err)
+		_, err = c.ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+		require.ErrorIs(t, err, apistatus.ErrObjectAlreadyRemoved)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var obj object.Object
+		obj.SetContainerID(cnr)
+		obj.SetOwner(usr.ID)
+		obj.SetPayload(payload)
+		require.NoError(t, obj.SetVerificationFields(usr))
+
+		w, err := c.ObjectPutInit(t.Context(), obj, usr, client.PrmObjectPutInit{})
+		require.NoError(t, err)
+		_, err = w.Write([]byte("other payload"))
+		require.NoError(t, err)
+		require.ErrorIs(t, w.Close(), apistatus.ErrBadRequest)
+	})
+}
+
+func TestNode_Split(t *testing.T) {
+	_, c := newNode(t)
+	usr := usertest.User()
+	cnr := putContainer(t, c, usr)
+
+	payload := make([]byte, 3*neofstest.DefaultMaxObjectSize+100)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+
+	s, err := slicer.New(t.Context(), c, usr, cnr, usr.ID, nil)
+	require.NoError(t, err)
+	id, err := s.Put(t.Context(), bytes.NewReader(payload), []object.Attribute{object.NewAttribute("k", "v")})
+	require.NoError(t, err)
+
+	hdr, res := getObject(t, c, usr, cnr, id)
+	require.Equal(t, id, hdr.GetID())
+	require.Equal(t, payload, res)
+
+	var prm client.PrmObjectHead
+	prm.MarkRaw()
+	_, err = c.ObjectHead(t.Context(), cnr, id, usr, prm)
+	var siErr *object.SplitInfoError
+	require.ErrorAs(t, err, &siErr)
+	require.False(t, siErr.SplitInfo().GetLink().IsZero())
+
+	var fs object.SearchFilters
+	fs.AddRootFilter()
+	items, _, err := c.SearchObjects(t.Context(), cnr, fs, nil, "", usr, client.SearchObjectsOptions{})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, id, items[0].ID)
+
+	fs = fs[:0]
+	fs.AddParentIDFilter(object.MatchStringEqual, id)
+	items, _, err = c.SearchObjects(t.Context(), cnr, fs, nil, "", usr, client.SearchObjectsOptions{})
+	require.NoError(t, err)
+	require.Len(t, items, 2) // last child and link
+
+	_, err = c.ObjectDelete(t.Context(), cnr, id, usr, client.PrmObjectDelete{})
+	require.NoError(t, err)
+	_, err = c.ObjectHead(t.Context(), cnr, id, usr, client.PrmObjectHead{})
+	require.ErrorIs(t, err, apistatus.ErrObjectAlreadyRemoved)
+}
+
+func TestNode_ObjectFormedByNode(t *testing.T) {
+	node, c := newNode(t)
+	usr := usertest.User()
+	cnr := putContainer(t, c, usr)
+
+	var obj object.Object
+	obj.SetContainerID(cnr)
+	obj.SetOwner(usr.ID)
+
+	w, err := c.ObjectPutInit(t.Context(), obj, usr, client.PrmObjectPutInit{})
+	require.NoError(t, err)
+	_, err = w.Write([]byte("payload"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	id := w.GetResult().StoredObjectID()
+	stored, ok := node.Object(oid.NewAddress(cnr, id))
+	require.True(t, ok)
+	require.NoError(t, stored.CheckVerificationFields())
+	require.Equal(t, []byte("payload"), stored.Payload())
+
+}
@@ -0,0 +1,693 @@
+package neofstest
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	neofsecdsa "github.com/nspcc-dev/neofs-sdk-go/crypto/ecdsa"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/nspcc-dev/tzhash/tz"
+	"google.golang.org/grpc"
+)
+
+// payloadChunkSize is a maximum size of payload chunks transmitted by the
+// Node in a single stream message.
+const payloadChunkSize = 64 << 10
+
+type objectService struct {
+	protoobject.UnimplementedObjectServiceServer
+	node *Node
+}
+
+func decodeAddress(m *refs.Address) (oid.Address, error) {
+	if m == nil {
+		return oid.Address{}, badRequest("missing object address")
+	}
+	var addr oid.Address
+	if err := addr.FromProtoMessage(m); err != nil {
+		return oid.Address{}, badRequest("invalid object address: %v", err)
+	}
+	return addr, nil
+}
+
+// Object returns object stored in the Node. Virtual parent objects of split
+// chains are assembled from their children. Returns false if the object is
+// missing, removed or cannot be assembled.
+func (n *Node) Object(addr oid.Address) (object.Object, bool) {
+	obj, si, err := n.getObject(addr, false, true, []*Node{n})
+	return obj, err == nil && si == nil
+}
+
+// PutObject saves object in the Node bypassing the ObjectService. The object
+// must have ID.
+func (n *Node) PutObject(obj object.Object) {
+	n.mtx.Lock()
+	n.storeObject(obj)
+	n.mtx.Unlock()
+}
+
+// objectState describes object known to the nodes.
+type objectState struct {
+	// nil if object is not stored.
+	obj *object.Object
+	// nil if object is not a parent of the split chain.
+	split   *splitRecord
+	removed bool
+	locked  bool
+}
+
+// localObject returns state of the object stored in the Node.
+func (n *Node) localObject(addr oid.Address) objectState {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+	var res objectState
+	if obj, ok := n.objects[addr]; ok {
+		res.obj = &obj
+	}
+	if rec, ok := n.splits[addr]; ok {
+		cp := *rec
+		res.split = &cp
+	}
+	_, res.removed = n.removed[addr]
+	_, res.locked = n.locked[addr]
+	return res
+}
+
+// lookupObject returns state of the object merged from all given nodes.
+func lookupObject(nodes []*Node, addr oid.Address) objectState {
+	var res objectState
+	for _, node := range nodes {
+		st := node.localObject(addr)
+		res.removed = res.removed || st.removed
+		res.locked = res.locked || st.locked
+		if res.obj == nil {
+			res.obj = st.obj
+		}
+		if st.split == nil {
+			continue
+		}
+		if res.split == nil {
+			res.split = &splitRecord{header: st.split.header}
+		}
+		if !st.split.first.IsZero() {
+			res.split.first = st.split.first
+		}
+		if !st.split.last.IsZero() {
+			res.split.last = st.split.last
+		}
+		if !st.split.link.IsZero() {
+			res.split.link = st.split.link
+		}
+	}
+	return res
+}
+
+// getObject returns object by address looking it up on the given nodes. If
+// object is a virtual parent of the split chain, it's assembled unless raw
+// flag is set or some children are missing. In these cases, split info is
+// returned. Payload is attached only if the corresponding flag is set.
+func (n *Node) getObject(addr oid.Address, raw, withPayload bool, nodes []*Node) (object.Object, *object.SplitInfo, error) {
+	if _, err := n.container(addr.Container()); err != nil {
+		return object.Object{}, nil, err
+	}
+	st := lookupObject(nodes, addr)
+	if st.removed {
+		return object.Object{}, nil, apistatus.ErrObjectAlreadyRemoved
+	}
+	if st.obj != nil {
+		if !withPayload {
+			return *st.obj.CutPayload(), nil, nil
+		}
+		return *st.obj, nil, nil
+	}
+
+	rec := st.split
+	if rec == nil {
+		return object.Object{}, nil, apistatus.ErrObjectNotFound
+	}
+	if !raw {
+		if children, ok := splitChildren(nodes, addr.Container(), rec); ok {
+			var parent object.Object
+			rec.header.CopyTo(&parent)
+			if withPayload {
+				payload := make([]byte, 0, parent.PayloadSize())
+				for i := range children {
+					payload = append(payload, children[i].Payload()...)
+				}
+				parent.SetPayload(payload)
+			}
+			return parent, nil, nil
+		}
+	}
+
+	si := object.NewSplitInfo()
+	if !rec.last.IsZero() {
+		si.SetLastPart(rec.last)
+	}
+	if !rec.link.IsZero() {
+		si.SetLink(rec.link)
+	}
+	if !rec.first.IsZero() {
+		si.SetFirstPart(rec.first)
+	}
+	return object.Object{}, si, nil
+}
+
+// splitChildren returns ordered children of the split chain looking them up
+// on the given nodes. Returns false if any child is missing.
+func splitChildren(nodes []*Node, cnr cid.ID, rec *splitRecord) ([]object.Object, bool) {
+	get := func(id oid.ID) (object.Object, bool) {
+		st := lookupObject(nodes, oid.NewAddress(cnr, id))
+		if st.obj == nil {
+			return object.Object{}, false
+		}
+		return *st.obj, true
+	}
+
+	var children []object.Object
+	if !rec.link.IsZero() {
+		link, ok := get(rec.link)
+		if !ok {
+			return nil, false
+		}
+		var l object.Link
+		if err := link.ReadLink(&l); err != nil {
+			return nil, false
+		}
+		for _, m := range l.Objects() {
+			child, ok := get(m.ObjectID())
+			if !ok {
+				return nil, false
+			}
+			children = append(children, child)
+		}
+	} else if !rec.last.IsZero() {
+		for id := rec.last; !id.IsZero(); {
+			child, ok := get(id)
+			if !ok {
+				return nil, false
+			}
+			children = append(children, child)
+			id = child.GetPreviousID()
+		}
+		slices.Reverse(children)
+	}
+	return children, len(children) > 0
+}
+
+// storeObject saves object and updates split and lock indices.
+//
+// Must be called under write lock.
+func (n *Node) storeObject(obj object.Object) {
+	addr := obj.Address()
+	n.objects[addr] = obj
+	delete(n.removed, addr)
+
+	if parent := obj.Parent(); parent != nil {
+		if parentID := parent.GetID(); !parentID.IsZero() {
+			parentAddr := oid.NewAddress(addr.Container(), parentID)
+			rec, ok := n.splits[parentAddr]
+			if !ok {
+				rec = new(splitRecord)
+				n.splits[parentAddr] = rec
+			}
+			parent.CopyTo(&rec.header)
+			rec.header.SetContainerID(addr.Container())
+			if obj.Type() == object.TypeLink {
+				rec.link = addr.Object()
+			} else {
+				rec.last = addr.Object()
+			}
+			if first, ok := obj.FirstID(); ok {
+				rec.first = first
+			}
+		}
+	}
+
+	if obj.Type() == object.TypeLock {
+		var l object.Lock
+		if err := obj.ReadLock(&l); err == nil {
+			members := make([]oid.ID, l.NumberOfMembers())
+			l.ReadMembers(members)
+			for i := range members {
+				n.locked[oid.NewAddress(addr.Container(), members[i])] = struct{}{}
+			}
+		}
+	}
+}
+
+// removeObject marks object as removed together with its split chain on all
+// given nodes.
+func (n *Node) removeObject(addr oid.Address, nodes []*Node) error {
+	if _, err := n.container(addr.Container()); err != nil {
+		return err
+	}
+	st := lookupObject(nodes, addr)
+	if st.locked {
+		return apistatus.ErrObjectLocked
+	}
+
+	addrs := []oid.Address{addr}
+	if rec := st.split; rec != nil {
+		children, _ := splitChildren(nodes, addr.Container(), rec)
+		for i := range children {
+			addrs = append(addrs, children[i].Address())
+		}
+		if !rec.link.IsZero() {
+			addrs = append(addrs, oid.NewAddress(addr.Container(), rec.link))
+		}
+	}
+	for _, node := range nodes {
+		node.markRemoved(addrs)
+	}
+	return nil
+}
+
+// markRemoved marks given objects as removed in the Node.
+func (n *Node) markRemoved(addrs []oid.Address) {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	for _, addr := range addrs {
+		delete(n.objects, addr)
+		delete(n.splits, addr)
+		n.removed[addr] = struct{}{}
+	}
+}
+
+// resolveRange returns payload range requested by the given range parameters.
+func resolveRange(rng *protoobject.Range, ext *protoobject.ExtendedRange, payloadLen uint64) (uint64, uint64, error) {
+	if ext != nil {
+		first, last := ext.FirstPos, ext.LastPos
+		switch {
+		case first != nil && last != nil:
+			if *first > *last {
+				return 0, 0, badRequest("first position %d exceeds last %d", *first, *last)
+			}
+			if *first >= payloadLen {
+				return 0, 0, apistatus.ErrObjectOutOfRange
+			}
+			return *first, min(*last, payloadLen-1) - *first + 1, nil
+		case first != nil:
+			if *first >= payloadLen {
+				return 0, 0, apistatus.ErrObjectOutOfRange
+			}
+			return *first, payloadLen - *first, nil
+		case last != nil:
+			ln := min(*last, payloadLen)
+			return payloadLen - ln, ln, nil
+		default:
+			return 0, 0, badRequest("empty extended range")
+		}
+	}
+
+	if rng.Length == 0 {
+		return 0, 0, badRequest("zero range length")
+	}
+	if rng.Offset+rng.Length < rng.Offset || rng.Offset+rng.Length > payloadLen {
+		return 0, 0, apistatus.ErrObjectOutOfRange
+	}
+	return rng.Offset, rng.Length, nil
+}
+
+func (s *objectService) Get(req *protoobject.GetRequest, stream grpc.ServerStreamingServer[protoobject.GetResponse]) error {
+	send := func(body *protoobject.GetResponse_Body, err error) error {
+		resp := &protoobject.GetResponse{Body: body, MetaHeader: s.node.responseMeta(err)}
+		if err := signResponse(s.node, resp, &resp.VerifyHeader); err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	}
+
+	if err := verifyRequest(req); err != nil {
+		return send(nil, err)
+	}
+	body := req.GetBody()
+	addr, err := decodeAddress(body.GetAddress())
+	if err != nil {
+		return send(nil, err)
+	}
+
+	obj, si, err := s.node.getObject(addr, body.Raw, true, s.node.objectNodes(addr.Container(), forwarded(req.GetMetaHeader())))
+	if err != nil {
+		return send(nil, err)
+	}
+	if si != nil {
+		return send(&protoobject.GetResponse_Body{ObjectPart: &protoobject.GetResponse_Body_SplitInfo{SplitInfo: si.ProtoMessage()}}, nil)
+	}
+
+	payload := obj.Payload()
+	if body.Range != nil || body.ExtendedRange != nil {
+		off, ln, err := resolveRange(body.Range, body.ExtendedRange, uint64(len(payload)))
+		if err != nil {
+			return send(nil, err)
+		}
+		payload = payload[off : off+ln]
+	}
+
+	if !body.PayloadOnly {
+		m := obj.ProtoMessage()
+		if err = send(&protoobject.GetResponse_Body{ObjectPart: &protoobject.GetResponse_Body_Init_{Init: &protoobject.GetResponse_Body_Init{
+			ObjectId:  m.ObjectId,
+			Signature: m.Signature,
+			Header:    m.Header,
+		}}}, nil); err != nil {
+			return err
+		}
+	}
+
+	for len(payload) > 0 {
+		chunk := payload[:min(len(payload), payloadChunkSize)]
+		if err = send(&protoobject.GetResponse_Body{ObjectPart: &protoobject.GetResponse_Body_Chunk{Chunk: chunk}}, nil); err != nil {
+			return err
+		}
+		payload = payload[len(chunk):]
+	}
+	return nil
+}
+
+func (s *objectService) Put(stream grpc.ClientStreamingServer[protoobject.PutRequest, protoobject.PutResponse]) error {
+	send := func(id oid.ID, err error) error {
+		resp := &protoobject.PutResponse{MetaHeader: s.node.responseMeta(err)}
+		if err == nil {
+			resp.Body = &protoobject.PutResponse_Body{ObjectId: id.ProtoMessage()}
+		}
+		if err := signResponse(s.node, resp, &resp.VerifyHeader); err != nil {
+			return err
+		}
+		return stream.SendAndClose(resp)
+	}
+
+	var obj object.Object
+	var payload []byte
+	var initialized, fwd bool
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err = verifyRequest(req); err != nil {
+			return send(oid.ID{}, err)
+		}
+
+		switch part := req.GetBody().GetObjectPart().(type) {
+		case *protoobject.PutRequest_Body_Init_:
+			if initialized {
+				return send(oid.ID{}, badRequest("repeated init part"))
+			}
+			if err = obj.FromProtoMessage(&protoobject.Object{
+				ObjectId:  part.Init.GetObjectId(),
+				Signature: part.Init.GetSignature(),
+				Header:    part.Init.GetHeader(),
+			}); err != nil {
+				return send(oid.ID{}, badRequest("invalid object header: %v", err))
+			}
+			initialized, fwd = true, forwarded(req.GetMetaHeader())
+		case *protoobject.PutRequest_Body_Chunk:
+			if !initialized {
+				return send(oid.ID{}, badRequest("chunk before init part"))
+			}
+			payload = append(payload, part.Chunk...)
+		default:
+			return send(oid.ID{}, badRequest("invalid object part %T", part))
+		}
+	}
+	if !initialized {
+		return send(oid.ID{}, badRequest("missing init part"))
+	}
+	obj.SetPayload(payload)
+
+	err := s.node.putObject(&obj, fwd)
+	return send(obj.GetID(), err)
+}
+
+// putObject verifies and saves object received via ObjectService. Object
+// without ID is formed by the Node. If fwd is set, the object is placed on
+// container nodes.
+func (n *Node) putObject(obj *object.Object, fwd bool) error {
+	if _, err := n.container(obj.GetContainerID()); err != nil {
+		return err
+	}
+	payloadLen := uint64(len(obj.Payload()))
+	if obj.GetID().IsZero() {
+		obj.SetPayloadSize(payloadLen)
+		n.mtx.RLock()
+		signer := n.objectSigner(*obj)
+		n.mtx.RUnlock()
+		if err := obj.SetVerificationFields(signer); err != nil {
+			return err
+		}
+	} else {
+		if obj.PayloadSize() != payloadLen {
+			return badRequest("payload size mismatch: header %d, actual %d", obj.PayloadSize(), payloadLen)
+		}
+		if err := obj.CheckVerificationFields(); err != nil {
+			return badRequest("invalid object: %v", err)
+		}
+	}
+	if !fwd || n.network == nil {
+		n.PutObject(*obj)
+		return nil
+	}
+	return n.placeObject(*obj)
+}
+
+// placeObject saves object on the available container nodes according to the
+// storage policy. Objects are stored in full on a single node for each EC rule.
+func (n *Node) placeObject(obj object.Object) error {
+	c, err := n.container(obj.GetContainerID())
+	if err != nil {
+		return err
+	}
+	vectors, err := n.containerNodes(obj.GetContainerID(), obj.GetID())
+	if err != nil {
+		return err
+	}
+	policy := c.PlacementPolicy()
+	var stored bool
+	for i := range vectors {
+		replicas := uint32(1)
+		if i < policy.NumberOfReplicas() {
+			replicas = policy.ReplicaNumberByIndex(i)
+		}
+		for _, node := range vectors[i] {
+			if replicas == 0 {
+				break
+			}
+			if node.down.Load() {
+				continue
+			}
+			node.PutObject(obj)
+			replicas--
+			stored = true
+		}
+	}
+	if !stored {
+		return errors.New("no available container nodes")
+	}
+	return nil
+}
+
+// objectSigner returns signer of the object formed by the Node: session key if
+// the object is created within the session opened on the Node, Node key
+// otherwise.
+//
+// Must be called under read lock.
+func (n *Node) objectSigner(obj object.Object) neofscrypto.Signer {
+	if st := obj.SessionToken(); st != nil {
+		if key, ok := n.sessions[st.ID()]; ok {
+			return neofsecdsa.Signer(key.PrivateKey)
+		}
+	}
+	return n.signer
+}
+
+func (s *objectService) Delete(_ context.Context, req *protoobject.DeleteRequest) (*protoobject.DeleteResponse, error) {
+	body, meta := execute(s.node, req, func(body *protoobject.DeleteRequest_Body) (*protoobject.DeleteResponse_Body, error) {
+		addr, err := decodeAddress(body.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = s.node.removeObject(addr, s.node.objectNodes(addr.Container(), forwarded(req.GetMetaHeader()))); err != nil {
+			return nil, err
+		}
+		return &protoobject.DeleteResponse_Body{
+			Tombstone: oid.NewAddress(addr.Container(), oidtest.ID()).ProtoMessage(),
+		}, nil
+	})
+	resp := &protoobject.DeleteResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *objectService) Head(_ context.Context, req *protoobject.HeadRequest) (*protoobject.HeadResponse, error) {
+	body, meta := execute(s.node, req, func(body *protoobject.HeadRequest_Body) (*protoobject.HeadResponse_Body, error) {
+		addr, err := decodeAddress(body.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+
+		obj, si, err := s.node.getObject(addr, body.Raw, false, s.node.objectNodes(addr.Container(), forwarded(req.GetMetaHeader())))
+		if err != nil {
+			return nil, err
+		}
+		if si != nil {
+			return &protoobject.HeadResponse_Body{Head: &protoobject.HeadResponse_Body_SplitInfo{SplitInfo: si.ProtoMessage()}}, nil
+		}
+		m := obj.ProtoMessage()
+		return &protoobject.HeadResponse_Body{Head: &protoobject.HeadResponse_Body_Header{Header: &protoobject.HeaderWithSignature{
+			Header:    m.Header,
+			Signature: m.Signature,
+		}}}, nil
+	})
+	resp := &protoobject.HeadResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *objectService) GetRange(req *protoobject.GetRangeRequest, stream grpc.ServerStreamingServer[protoobject.GetRangeResponse]) error {
+	send := func(body *protoobject.GetRangeResponse_Body, err error) error {
+		resp := &protoobject.GetRangeResponse{Body: body, MetaHeader: s.node.responseMeta(err)}
+		if err := signResponse(s.node, resp, &resp.VerifyHeader); err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	}
+
+	if err := verifyRequest(req); err != nil {
+		return send(nil, err)
+	}
+	body := req.GetBody()
+	addr, err := decodeAddress(body.GetAddress())
+	if err != nil {
+		return send(nil, err)
+	}
+	if body.Range == nil {
+		return send(nil, badRequest("missing range"))
+	}
+
+	obj, si, err := s.node.getObject(addr, body.Raw, true, s.node.objectNodes(addr.Container(), forwarded(req.GetMetaHeader())))
+	if err != nil {
+		return send(nil, err)
+	}
+	if si != nil {
+		return send(&protoobject.GetRangeResponse_Body{RangePart: &protoobject.GetRangeResponse_Body_SplitInfo{SplitInfo: si.ProtoMessage()}}, nil)
+	}
+
+	payload := obj.Payload()
+	off, ln, err := resolveRange(body.Range, nil, uint64(len(payload)))
+	if err != nil {
+		return send(nil, err)
+	}
+	payload = payload[off : off+ln]
+
+	for len(payload) > 0 {
+		chunk := payload[:min(len(payload), payloadChunkSize)]
+		if err = send(&protoobject.GetRangeResponse_Body{RangePart: &protoobject.GetRangeResponse_Body_Chunk{Chunk: chunk}}, nil); err != nil {
+			return err
+		}
+		payload = payload[len(chunk):]
+	}
+	return nil
+}
+
+func (s *objectService) GetRangeHash(_ context.Context, req *protoobject.GetRangeHashRequest) (*protoobject.GetRangeHashResponse, error) {
+	body, meta := execute(s.node, req, func(body *protoobject.GetRangeHashRequest_Body) (*protoobject.GetRangeHashResponse_Body, error) {
+		addr, err := decodeAddress(body.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		if len(body.Ranges) == 0 {
+			return nil, badRequest("missing ranges")
+		}
+		var hashFunc func([]byte) []byte
+		switch body.Type {
+		case refs.ChecksumType_SHA256:
+			hashFunc = func(b []byte) []byte { h := sha256.Sum256(b); return h[:] }
+		case refs.ChecksumType_TZ:
+			hashFunc = func(b []byte) []byte { h := tz.Sum(b); return h[:] }
+		default:
+			return nil, badRequest("unsupported checksum type %v", body.Type)
+		}
+
+		obj, si, err := s.node.getObject(addr, false, true, s.node.objectNodes(addr.Container(), forwarded(req.GetMetaHeader())))
+		if err != nil {
+			return nil, err
+		}
+		if si != nil {
+			return nil, apistatus.ErrObjectNotFound
+		}
+
+		payload := obj.Payload()
+		res := &protoobject.GetRangeHashResponse_Body{Type: body.Type, HashList: make([][]byte, len(body.Ranges))}
+		for i, rng := range body.Ranges {
+			off, ln, err := resolveRange(rng, nil, uint64(len(payload)))
+			if err != nil {
+				return nil, err
+			}
+			data := slices.Clone(payload[off : off+ln])
+			if len(body.Salt) > 0 {
+				for j := range data {
+					data[j] ^= body.Salt[j%len(body.Salt)]
+				}
+			}
+			res.HashList[i] = hashFunc(data)
+		}
+		return res, nil
+	})
+	resp := &protoobject.GetRangeHashResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
+
+func (s *objectService) Replicate(_ context.Context, req *protoobject.ReplicateRequest) (*protoobject.ReplicateResponse, error) {
+	sig, err := s.replicate(req)
+	return &protoobject.ReplicateResponse{Status: apistatus.FromError(err), ObjectSignature: sig}, nil
+}
+
+func (s *objectService) replicate(req *protoobject.ReplicateRequest) ([]byte, error) {
+	if req.Object == nil {
+		return nil, badRequest("missing object")
+	}
+	var obj object.Object
+	if err := obj.FromProtoMessage(req.Object); err != nil {
+		return nil, badRequest("invalid object: %v", err)
+	}
+	if err := obj.CheckVerificationFields(); err != nil {
+		return nil, badRequest("invalid object: %v", err)
+	}
+	id := obj.GetID()
+	if req.Signature == nil {
+		return nil, badRequest("missing signature")
+	}
+	if !neofscrypto.NewSignatureFromRawKey(neofscrypto.Scheme(req.Signature.Scheme), req.Signature.Key, req.Signature.Sign).Verify(id[:]) {
+		var st apistatus.SignatureVerification
+		st.SetMessage("invalid object ID signature")
+		return nil, st
+	}
+
+	if _, err := s.node.container(obj.GetContainerID()); err != nil {
+		return nil, err
+	}
+	s.node.PutObject(obj)
+
+	if !req.SignObject {
+		return nil, nil
+	}
+	v, err := s.node.signer.Sign(id[:])
+	if err != nil {
+		return nil, fmt.Errorf("sign object: %w", err)
+	}
+	return neofscrypto.NewSignature(s.node.signer.Scheme(), s.node.signer.Public(), v).Marshal(), nil
+}
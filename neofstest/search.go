@@ -0,0 +1,336 @@
+package neofstest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	protoobject "github.com/nspcc-dev/neofs-sdk-go/proto/object"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"google.golang.org/grpc"
+)
+
+// searchIDBatch is a maximum number of IDs transmitted by the Node in a single
+// SearchResponse.
+const searchIDBatch = 1000
+
+// searchCandidate is an object header processed by the search.
+type searchCandidate struct {
+	header object.Object
+	// whether object is stored physically, i.e. is not a virtual parent.
+	phy bool
+}
+
+// attribute returns value of the object attribute including system ones.
+func (c searchCandidate) attribute(key string) (string, bool) {
+	hdr := c.header
+	switch key {
+	case object.FilterRoot:
+		return "", !c.phy || !hdr.HasParent()
+	case object.FilterPhysical:
+		return "", c.phy
+	case object.FilterID:
+		return hdr.GetID().EncodeToString(), true
+	case object.FilterContainerID:
+		return hdr.GetContainerID().EncodeToString(), true
+	case object.FilterVersion:
+		if v := hdr.Version(); v != nil {
+			return version.EncodeToString(*v), true
+		}
+		return "", false
+	case object.FilterOwnerID:
+		if owner := hdr.Owner(); !owner.IsZero() {
+			return owner.EncodeToString(), true
+		}
+		return "", false
+	case object.FilterPayloadChecksum:
+		if cs, ok := hdr.PayloadChecksum(); ok {
+			return hex.EncodeToString(cs.Value()), true
+		}
+		return "", false
+	case object.FilterPayloadHomomorphicHash:
+		if cs, ok := hdr.PayloadHomomorphicHash(); ok {
+			return hex.EncodeToString(cs.Value()), true
+		}
+		return "", false
+	case object.FilterType:
+		return hdr.Type().String(), true
+	case object.FilterParentID:
+		if id := hdr.GetParentID(); !id.IsZero() {
+			return id.EncodeToString(), true
+		}
+		return "", false
+	case object.FilterSplitID:
+		if id := hdr.SplitID(); id != nil {
+			return id.String(), true
+		}
+		return "", false
+	case object.FilterFirstSplitObject:
+		if id, ok := hdr.FirstID(); ok {
+			return id.EncodeToString(), true
+		}
+		return "", false
+	case object.FilterCreationEpoch:
+		return strconv.FormatUint(hdr.CreationEpoch(), 10), true
+	case object.FilterPayloadSize:
+		return strconv.FormatUint(hdr.PayloadSize(), 10), true
+	}
+	for _, a := range hdr.Attributes() {
+		if a.Key() == key {
+			return a.Value(), true
+		}
+	}
+	return "", false
+}
+
+// matches checks whether the candidate matches all the filters.
+func (c searchCandidate) matches(fs []*protoobject.SearchFilter) bool {
+	for _, f := range fs {
+		val, ok := c.attribute(f.Key)
+		switch f.MatchType {
+		case protoobject.MatchType_MATCH_TYPE_UNSPECIFIED:
+			if !ok {
+				return false
+			}
+		case protoobject.MatchType_STRING_EQUAL:
+			if !ok || val != f.Value {
+				return false
+			}
+		case protoobject.MatchType_STRING_NOT_EQUAL:
+			if !ok || val == f.Value {
+				return false
+			}
+		case protoobject.MatchType_NOT_PRESENT:
+			if ok {
+				return false
+			}
+		case protoobject.MatchType_COMMON_PREFIX:
+			if !ok || !strings.HasPrefix(val, f.Value) {
+				return false
+			}
+		case protoobject.MatchType_NUM_GT, protoobject.MatchType_NUM_GE, protoobject.MatchType_NUM_LT, protoobject.MatchType_NUM_LE:
+			if !ok {
+				return false
+			}
+			x, okX := new(big.Int).SetString(val, 10)
+			y, okY := new(big.Int).SetString(f.Value, 10)
+			if !okX || !okY {
+				return false
+			}
+			cmp := x.Cmp(y)
+			if f.MatchType == protoobject.MatchType_NUM_GT && cmp <= 0 ||
+				f.MatchType == protoobject.MatchType_NUM_GE && cmp < 0 ||
+				f.MatchType == protoobject.MatchType_NUM_LT && cmp >= 0 ||
+				f.MatchType == protoobject.MatchType_NUM_LE && cmp > 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isNumericMatch(m protoobject.MatchType) bool {
+	return m >= protoobject.MatchType_NUM_GT && m <= protoobject.MatchType_NUM_LE
+}
+
+// search returns container objects matching the filters from all given
+// nodes. Both stored objects and virtual parents are processed.
+func (n *Node) search(cnr cid.ID, fs []*protoobject.SearchFilter, nodes []*Node) ([]searchCandidate, error) {
+	if _, err := n.container(cnr); err != nil {
+		return nil, err
+	}
+	var res []searchCandidate
+	seen := make(map[oid.ID]struct{})
+	for _, node := range nodes {
+		for _, c := range node.searchLocal(cnr, fs) {
+			if _, ok := seen[c.header.GetID()]; !ok {
+				seen[c.header.GetID()] = struct{}{}
+				res = append(res, c)
+			}
+		}
+	}
+	return res, nil
+}
+
+// searchLocal returns container objects matching the filters stored in the
+// Node.
+func (n *Node) searchLocal(cnr cid.ID, fs []*protoobject.SearchFilter) []searchCandidate {
+	n.mtx.RLock()
+	defer n.mtx.RUnlock()
+	var res []searchCandidate
+	for addr, obj := range n.objects {
+		if addr.Container() != cnr {
+			continue
+		}
+		if c := (searchCandidate{header: *obj.CutPayload(), phy: true}); c.matches(fs) {
+			res = append(res, c)
+		}
+	}
+	for addr, rec := range n.splits {
+		if addr.Container() != cnr {
+			continue
+		}
+		if _, ok := n.removed[addr]; ok {
+			continue
+		}
+		if c := (searchCandidate{header: rec.header}); c.matches(fs) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (s *objectService) Search(req *protoobject.SearchRequest, stream grpc.ServerStreamingServer[protoobject.SearchResponse]) error {
+	send := func(body *protoobject.SearchResponse_Body, err error) error {
+		resp := &protoobject.SearchResponse{Body: body, MetaHeader: s.node.responseMeta(err)}
+		if err := signResponse(s.node, resp, &resp.VerifyHeader); err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	}
+
+	if err := verifyRequest(req); err != nil {
+		return send(nil, err)
+	}
+	cnr, err := decodeContainerID(req.GetBody().GetContainerId())
+	if err != nil {
+		return send(nil, err)
+	}
+
+	res, err := s.node.search(cnr, req.Body.Filters, s.node.objectNodes(cnr, forwarded(req.GetMetaHeader())))
+	if err != nil {
+		return send(nil, err)
+	}
+
+	ids := make([]*refs.ObjectID, len(res))
+	for i := range res {
+		ids[i] = res[i].header.GetID().ProtoMessage()
+	}
+	for len(ids) > 0 {
+		batch := ids[:min(len(ids), searchIDBatch)]
+		if err = send(&protoobject.SearchResponse_Body{IdList: batch}, nil); err != nil {
+			return err
+		}
+		ids = ids[len(batch):]
+	}
+	return nil
+}
+
+// searchItem is a single SearchV2 result.
+type searchItem struct {
+	id    oid.ID
+	attrs []string
+}
+
+// encodeCursor returns cursor pointing to the given item. Cursor is a Base64
+// of the first attribute value followed by the object ID.
+func encodeCursor(item searchItem) string {
+	var b []byte
+	if len(item.attrs) > 0 {
+		b = append(b, item.attrs[0]...)
+	}
+	return base64.StdEncoding.EncodeToString(append(b, item.id[:]...))
+}
+
+func decodeCursor(s string) (searchItem, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return searchItem{}, badRequest("invalid cursor: %v", err)
+	}
+	if len(b) < oid.Size {
+		return searchItem{}, badRequest("invalid cursor: too short")
+	}
+	item := searchItem{id: oid.ID(b[len(b)-oid.Size:])}
+	item.attrs = []string{string(b[:len(b)-oid.Size])}
+	return item, nil
+}
+
+func compareSearchItems(a, b searchItem, numeric bool) int {
+	if len(a.attrs) > 0 && len(b.attrs) > 0 {
+		if numeric {
+			x, okX := new(big.Int).SetString(a.attrs[0], 10)
+			y, okY := new(big.Int).SetString(b.attrs[0], 10)
+			if okX && okY {
+				if cmp := x.Cmp(y); cmp != 0 {
+					return cmp
+				}
+			} else if cmp := strings.Compare(a.attrs[0], b.attrs[0]); cmp != 0 {
+				return cmp
+			}
+		} else if cmp := strings.Compare(a.attrs[0], b.attrs[0]); cmp != 0 {
+			return cmp
+		}
+	}
+	return bytes.Compare(a.id[:], b.id[:])
+}
+
+func (s *objectService) SearchV2(_ context.Context, req *protoobject.SearchV2Request) (*protoobject.SearchV2Response, error) {
+	body, meta := execute(s.node, req, func(body *protoobject.SearchV2Request_Body) (*protoobject.SearchV2Response_Body, error) {
+		cnr, err := decodeContainerID(body.GetContainerId())
+		if err != nil {
+			return nil, err
+		}
+		if body.Count == 0 {
+			return nil, badRequest("zero count")
+		}
+		if len(body.Attributes) > 0 && (len(body.Filters) == 0 || body.Filters[0].Key != body.Attributes[0]) {
+			return nil, badRequest("1st attribute %q is requested but not filtered 1st", body.Attributes[0])
+		}
+
+		res, err := s.node.search(cnr, body.Filters, s.node.objectNodes(cnr, forwarded(req.GetMetaHeader())))
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]searchItem, len(res))
+		for i := range res {
+			items[i].id = res[i].header.GetID()
+			items[i].attrs = make([]string, len(body.Attributes))
+			for j := range body.Attributes {
+				items[i].attrs[j], _ = res[i].attribute(body.Attributes[j])
+			}
+		}
+		numeric := len(body.Attributes) > 0 && isNumericMatch(body.Filters[0].MatchType)
+		slices.SortFunc(items, func(a, b searchItem) int { return compareSearchItems(a, b, numeric) })
+
+		if body.Cursor != "" {
+			cursor, err := decodeCursor(body.Cursor)
+			if err != nil {
+				return nil, err
+			}
+			if len(body.Attributes) == 0 {
+				cursor.attrs = nil
+			}
+			items = slices.DeleteFunc(items, func(item searchItem) bool {
+				return compareSearchItems(item, cursor, numeric) <= 0
+			})
+		}
+
+		resBody := new(protoobject.SearchV2Response_Body)
+		if uint32(len(items)) > body.Count {
+			items = items[:body.Count]
+			resBody.Cursor = encodeCursor(items[len(items)-1])
+		}
+		resBody.Result = make([]*protoobject.SearchV2Response_OIDWithMeta, len(items))
+		for i := range items {
+			resBody.Result[i] = &protoobject.SearchV2Response_OIDWithMeta{
+				Id:         items[i].id.ProtoMessage(),
+				Attributes: items[i].attrs,
+			}
+		}
+		return resBody, nil
+	})
+	resp := &protoobject.SearchV2Response{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
@@ -0,0 +1,41 @@
+package neofstest
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	protosession "github.com/nspcc-dev/neofs-sdk-go/proto/session"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+type sessionService struct {
+	protosession.UnimplementedSessionServiceServer
+	node *Node
+}
+
+func (s *sessionService) Create(_ context.Context, req *protosession.CreateRequest) (*protosession.CreateResponse, error) {
+	body, meta := execute(s.node, req, func(body *protosession.CreateRequest_Body) (*protosession.CreateResponse_Body, error) {
+		if body.GetOwnerId() == nil {
+			return nil, badRequest("missing session owner")
+		}
+		var usr user.ID
+		if err := usr.FromProtoMessage(body.OwnerId); err != nil {
+			return nil, badRequest("invalid session owner: %v", err)
+		}
+
+		key, err := keys.NewPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		id := uuid.New()
+
+		s.node.mtx.Lock()
+		s.node.sessions[id] = key
+		s.node.mtx.Unlock()
+
+		return &protosession.CreateResponse_Body{Id: id[:], SessionKey: key.PublicKey().Bytes()}, nil
+	})
+	resp := &protosession.CreateResponse{Body: body, MetaHeader: meta}
+	return resp, signResponse(s.node, resp, &resp.VerifyHeader)
+}
@@ -0,0 +1,255 @@
+package netmap
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+// RejectReason enumerates reasons why a storage node is not selected for the
+// container by a [Selector].
+type RejectReason uint8
+
+const (
+	_ RejectReason = iota
+	// RejectReasonFilter is set when the node does not match the filter the
+	// selector selects from.
+	RejectReasonFilter
+	// RejectReasonSmallBucket is set when the node is in a bucket that has less
+	// nodes than the selector requires.
+	RejectReasonSmallBucket
+	// RejectReasonBackupFactor is set when the node is in a suitable bucket, but
+	// the bucket already contains the maximum number of nodes limited by the
+	// container backup factor (CBF).
+	RejectReasonBackupFactor
+	// RejectReasonNotSelected is set when the node is in a suitable bucket, but
+	// other buckets have higher priority for the container.
+	RejectReasonNotSelected
+)
+
+// String implements [fmt.Stringer].
+func (x RejectReason) String() string {
+	switch x {
+	default:
+		return "UNKNOWN#" + strconv.FormatUint(uint64(x), 10)
+	case RejectReasonFilter:
+		return "FILTER"
+	case RejectReasonSmallBucket:
+		return "SMALL_BUCKET"
+	case RejectReasonBackupFactor:
+		return "BACKUP_FACTOR"
+	case RejectReasonNotSelected:
+		return "NOT_SELECTED"
+	}
+}
+
+// RejectedNode describes storage node not selected by a [Selector].
+type RejectedNode struct {
+	// Rejected node.
+	Node NodeInfo
+	// Reason of the rejection.
+	Reason RejectReason
+	// Value of the selector's bucket attribute of the node. Empty if the
+	// selector has no bucket attribute or the node is rejected by filter.
+	Bucket string
+}
+
+// FilterExplanation describes the result of applying the named [Filter] to the
+// [NetMap] nodes.
+type FilterExplanation struct {
+	// Filter name.
+	Name string
+	// Nodes matching the filter in the network map order.
+	Matched []NodeInfo
+	// Nodes not matching the filter in the network map order.
+	Rejected []NodeInfo
+}
+
+// SelectorExplanation describes the result of applying the [Selector] to the
+// [NetMap] nodes.
+type SelectorExplanation struct {
+	// Selector name. Empty for selectors implied by replica and EC rule
+	// descriptors of the policy without selectors.
+	Name string
+	// Name of the filter the selector selects nodes from. '*' means all nodes.
+	Filter string
+	// Number of buckets the selector needs.
+	RequiredBuckets int
+	// Minimum number of nodes in each bucket.
+	NodesInBucket int
+	// Number of non-empty buckets formed from the matched nodes.
+	Buckets int
+	// Selected nodes in the same order as in [NetMap.ContainerNodes] result.
+	// Empty if Err is set.
+	Selected []NodeInfo
+	// Nodes that are not selected along with the reasons.
+	Rejected []RejectedNode
+	// Non-nil error if selector cannot be satisfied in the network map. It
+	// is [ErrNotEnoughNodes] then.
+	Err error
+}
+
+// PlacementExplanation describes how the [PlacementPolicy] is applied to the
+// [NetMap] for the particular container. See [NetMap.ExplainPlacement].
+type PlacementExplanation struct {
+	// Explanations of named filters in the policy order.
+	Filters []FilterExplanation
+	// Explanations of selectors in the policy order. If policy has no
+	// selectors, contains one unnamed selector per replica descriptor
+	// followed by one unnamed selector per EC rule.
+	Selectors []SelectorExplanation
+}
+
+// Satisfiable checks whether all selectors of the policy can be satisfied, i.e.
+// [NetMap.ContainerNodes] succeeds for it.
+func (x PlacementExplanation) Satisfiable() bool {
+	return !slices.ContainsFunc(x.Selectors, func(s SelectorExplanation) bool { return s.Err != nil })
+}
+
+// ExplainPlacement applies the given PlacementPolicy to the NetMap the same way
+// [NetMap.ContainerNodes] does and reports details of the process: which nodes
+// matched each filter, which nodes were selected by each selector and why
+// others were not. It allows to check policy against a network map before
+// creating the container.
+//
+// Unlike ContainerNodes, ExplainPlacement does not stop on the first
+// unsatisfiable selector: each of them is reported in the resulting
+// [SelectorExplanation.Err]. Use [PlacementExplanation.Satisfiable] to check
+// the policy at once. Returns error only if the policy is malformed.
+func (m NetMap) ExplainPlacement(p PlacementPolicy, containerID cid.ID) (PlacementExplanation, error) {
+	var res PlacementExplanation
+
+	c := newContext(m)
+	c.setCBF(p.backupFactor)
+	c.setPivot(slices.Clone(containerID[:]))
+
+	if err := c.processFilters(p); err != nil {
+		return res, err
+	}
+
+	res.Filters = make([]FilterExplanation, len(p.filters))
+	for i := range p.filters {
+		res.Filters[i].Name = p.filters[i].Name()
+		f := c.processedFilters[res.Filters[i].Name]
+		for j := range m.nodes {
+			if c.match(f, m.nodes[j]) {
+				res.Filters[i].Matched = append(res.Filters[i].Matched, m.nodes[j])
+			} else {
+				res.Filters[i].Rejected = append(res.Filters[i].Rejected, m.nodes[j])
+			}
+		}
+	}
+
+	names := make(map[string]struct{}, len(p.selectors))
+	for i := range p.selectors {
+		if fName := p.selectors[i].FilterName(); fName != mainFilterName {
+			if _, ok := c.processedFilters[fName]; !ok {
+				return res, fmt.Errorf("%w: SELECT FROM '%s'", errFilterNotFound, fName)
+			}
+		}
+		names[p.selectors[i].Name()] = struct{}{}
+	}
+
+	for i := range p.replicas {
+		if sName := p.replicas[i].SelectorName(); sName != "" {
+			if _, ok := names[sName]; !ok {
+				return res, fmt.Errorf("selector not found: REPLICA '%s'", sName)
+			}
+		}
+	}
+	for i := range p.ecRules {
+		if sName := p.ecRules[i].SelectorName(); sName != "" {
+			if _, ok := names[sName]; !ok {
+				return res, fmt.Errorf("selector not found: EC '%s'", sName)
+			}
+		}
+	}
+
+	if len(p.selectors) > 0 {
+		res.Selectors = make([]SelectorExplanation, len(p.selectors))
+		for i := range p.selectors {
+			res.Selectors[i] = c.explainSelection(p, p.selectors[i])
+		}
+		return res, nil
+	}
+
+	res.Selectors = make([]SelectorExplanation, 0, len(p.replicas)+len(p.ecRules))
+	for i := range p.replicas {
+		var s Selector
+		s.SetNumberOfNodes(p.replicas[i].NumberOfObjects())
+		s.SetFilterName(mainFilterName)
+		res.Selectors = append(res.Selectors, c.explainSelection(p, s))
+	}
+	for i := range p.ecRules {
+		var s Selector
+		s.SetNumberOfNodes(p.ecRules[i].DataPartNum() + p.ecRules[i].ParityPartNum())
+		s.SetFilterName(mainFilterName)
+		res.Selectors = append(res.Selectors, c.explainSelection(p, s))
+	}
+
+	return res, nil
+}
+
+// explainSelection makes getSelection for s and reports the details.
+func (c *context) explainSelection(p PlacementPolicy, s Selector) SelectorExplanation {
+	res := SelectorExplanation{
+		Name:   s.Name(),
+		Filter: s.FilterName(),
+	}
+	res.RequiredBuckets, res.NodesInBucket = calcNodesCount(s)
+
+	sel, err := c.getSelection(p, s)
+	if err != nil {
+		res.Err = err
+	} else {
+		res.Selected = flattenNodes(sel)
+	}
+
+	// nodes are identified by public keys, counters handle duplicates
+	selected := make(map[string]int, len(res.Selected))
+	for i := range res.Selected {
+		selected[string(res.Selected[i].PublicKey())]++
+	}
+
+	var f *Filter
+	if res.Filter != mainFilterName {
+		f = c.processedFilters[res.Filter]
+	}
+	for i := range c.netMap.nodes {
+		if res.Filter != mainFilterName && !c.match(f, c.netMap.nodes[i]) {
+			res.Rejected = append(res.Rejected, RejectedNode{Node: c.netMap.nodes[i], Reason: RejectReasonFilter})
+		}
+	}
+
+	// buckets are formed the same way as in getSelection, and nodes in them have
+	// the same order
+	buckets := c.getSelectionBase(s)
+	slices.SortStableFunc(buckets, func(a, b nodeAttrPair) int { return cmp.Compare(a.attr, b.attr) })
+	res.Buckets = len(buckets)
+
+	maxNodesInBucket := res.NodesInBucket * int(c.cbf)
+	for i := range buckets {
+		for j, n := range buckets[i].nodes {
+			if k := string(n.PublicKey()); selected[k] > 0 {
+				selected[k]--
+				continue
+			}
+
+			rn := RejectedNode{Node: n, Bucket: buckets[i].attr}
+			switch {
+			case len(buckets[i].nodes) < res.NodesInBucket:
+				rn.Reason = RejectReasonSmallBucket
+			case j >= maxNodesInBucket:
+				rn.Reason = RejectReasonBackupFactor
+			default:
+				rn.Reason = RejectReasonNotSelected
+			}
+			res.Rejected = append(res.Rejected, rn)
+		}
+	}
+
+	return res
+}
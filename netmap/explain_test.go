@@ -0,0 +1,192 @@
+package netmap
+
+import (
+	"testing"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/stretchr/testify/require"
+)
+
+func newExplainTestNetMap(countries ...string) NetMap {
+	ns := make([]NodeInfo, len(countries))
+	for i := range ns {
+		ns[i] = nodeInfoFromAttributes("Country", countries[i], "Price", "1", "Capacity", "10")
+		pub := make([]byte, 33)
+		pub[0] = byte(i)
+		ns[i].SetPublicKey(pub)
+	}
+
+	var nm NetMap
+	nm.SetNodes(ns)
+	return nm
+}
+
+func decodeExplainTestPolicy(t testing.TB, s string) PlacementPolicy {
+	var p PlacementPolicy
+	require.NoError(t, p.DecodeString(s))
+	return p
+}
+
+func reasonsOf(rs []RejectedNode) map[string]RejectReason {
+	res := make(map[string]RejectReason, len(rs))
+	for i := range rs {
+		res[string(rs[i].Node.PublicKey())] = rs[i].Reason
+	}
+	return res
+}
+
+func TestRejectReason_String(t *testing.T) {
+	for _, tc := range []struct {
+		r RejectReason
+		s string
+	}{
+		{0, "UNKNOWN#0"},
+		{RejectReasonFilter, "FILTER"},
+		{RejectReasonSmallBucket, "SMALL_BUCKET"},
+		{RejectReasonBackupFactor, "BACKUP_FACTOR"},
+		{RejectReasonNotSelected, "NOT_SELECTED"},
+		{42, "UNKNOWN#42"},
+	} {
+		require.Equal(t, tc.s, tc.r.String())
+	}
+}
+
+func TestNetMap_ExplainPlacement(t *testing.T) {
+	cnr := cid.ID{1, 2, 3}
+
+	t.Run("satisfiable", func(t *testing.T) {
+		nm := newExplainTestNetMap("RU", "RU", "RU", "DE", "DE", "US", "FR")
+		p := decodeExplainTestPolicy(t, `REP 1 IN X
+CBF 1
+SELECT 2 IN SAME Country FROM NotUS AS X
+FILTER Country NE US AS NotUS`)
+
+		res, err := nm.ExplainPlacement(p, cnr)
+		require.NoError(t, err)
+		require.True(t, res.Satisfiable())
+
+		require.Len(t, res.Filters, 1)
+		require.Equal(t, "NotUS", res.Filters[0].Name)
+		require.Equal(t, []NodeInfo{nm.nodes[0], nm.nodes[1], nm.nodes[2], nm.nodes[3], nm.nodes[4], nm.nodes[6]}, res.Filters[0].Matched)
+		require.Equal(t, []NodeInfo{nm.nodes[5]}, res.Filters[0].Rejected)
+
+		require.Len(t, res.Selectors, 1)
+		s := res.Selectors[0]
+		require.NoError(t, s.Err)
+		require.Equal(t, "X", s.Name)
+		require.Equal(t, "NotUS", s.Filter)
+		require.Equal(t, 1, s.RequiredBuckets)
+		require.Equal(t, 2, s.NodesInBucket)
+		require.Equal(t, 3, s.Buckets)
+
+		cnrNodes, err := nm.ContainerNodes(p, cnr)
+		require.NoError(t, err)
+		require.Equal(t, cnrNodes[0], s.Selected)
+		require.Len(t, s.Selected, 2)
+
+		reasons := reasonsOf(s.Rejected)
+		require.Len(t, reasons, len(nm.nodes)-len(s.Selected))
+		require.Equal(t, RejectReasonFilter, reasons[string(nm.nodes[5].PublicKey())])
+		require.Equal(t, RejectReasonSmallBucket, reasons[string(nm.nodes[6].PublicKey())])
+
+		for i := range s.Rejected {
+			switch s.Rejected[i].Bucket {
+			case "":
+				require.Equal(t, RejectReasonFilter, s.Rejected[i].Reason)
+			case "RU":
+				if s.Selected[0].Attribute("Country") == "RU" {
+					require.Equal(t, RejectReasonBackupFactor, s.Rejected[i].Reason)
+				} else {
+					require.Equal(t, RejectReasonNotSelected, s.Rejected[i].Reason)
+				}
+			case "DE":
+				require.Equal(t, RejectReasonNotSelected, s.Rejected[i].Reason)
+				require.NotEqual(t, "DE", s.Selected[0].Attribute("Country"))
+			case "FR":
+				require.Equal(t, RejectReasonSmallBucket, s.Rejected[i].Reason)
+			default:
+				t.Fatalf("unexpected bucket %q", s.Rejected[i].Bucket)
+			}
+		}
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		nm := newExplainTestNetMap("RU", "RU", "DE", "US")
+		p := decodeExplainTestPolicy(t, `REP 1 IN X
+REP 1 IN Y
+SELECT 3 IN DISTINCT Country FROM NotUS AS X
+SELECT 1 IN DISTINCT Country FROM * AS Y
+FILTER Country NE US AS NotUS`)
+
+		_, err := nm.ContainerNodes(p, cnr)
+		require.ErrorIs(t, err, ErrNotEnoughNodes)
+
+		res, err := nm.ExplainPlacement(p, cnr)
+		require.NoError(t, err)
+		require.False(t, res.Satisfiable())
+
+		require.Len(t, res.Selectors, 2)
+		require.ErrorIs(t, res.Selectors[0].Err, ErrNotEnoughNodes)
+		require.EqualError(t, res.Selectors[0].Err, "not enough nodes to SELECT from: 'X'")
+		require.Equal(t, 3, res.Selectors[0].RequiredBuckets)
+		require.Equal(t, 2, res.Selectors[0].Buckets)
+		require.Empty(t, res.Selectors[0].Selected)
+		require.Len(t, res.Selectors[0].Rejected, len(nm.nodes))
+
+		require.NoError(t, res.Selectors[1].Err)
+		require.NotEmpty(t, res.Selectors[1].Selected)
+	})
+
+	t.Run("without selectors", func(t *testing.T) {
+		nm := newExplainTestNetMap("RU", "DE", "US")
+		p := decodeExplainTestPolicy(t, `REP 2
+REP 4`)
+
+		res, err := nm.ExplainPlacement(p, cnr)
+		require.NoError(t, err)
+		require.False(t, res.Satisfiable())
+		require.Empty(t, res.Filters)
+		require.Len(t, res.Selectors, 2)
+
+		require.Empty(t, res.Selectors[0].Name)
+		require.Equal(t, "*", res.Selectors[0].Filter)
+		require.NoError(t, res.Selectors[0].Err)
+		require.Len(t, res.Selectors[0].Selected, 3) // backup factor 3 by default
+
+		require.ErrorIs(t, res.Selectors[1].Err, ErrNotEnoughNodes)
+		require.Equal(t, 4, res.Selectors[1].RequiredBuckets)
+		require.Equal(t, 3, res.Selectors[1].Buckets)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		nm := newExplainTestNetMap("RU")
+		for _, tc := range []struct {
+			name, err string
+			p         PlacementPolicy
+		}{
+			{name: "missing selector", err: "selector not found: REPLICA 'Y'",
+				p: newPlacementPolicy(1, []ReplicaDescriptor{newReplica(1, "Y")},
+					[]Selector{newSelector("X", "", 1, "*", (*Selector).SelectDistinct)}, nil)},
+			{name: "missing EC selector", err: "selector not found: EC 'Y'",
+				p: func() PlacementPolicy {
+					p := newPlacementPolicy(1, nil, []Selector{newSelector("X", "", 1, "*", (*Selector).SelectDistinct)}, nil)
+					r := NewECRule(1, 1)
+					r.SetSelectorName("Y")
+					p.SetECRules([]ECRule{r})
+					return p
+				}()},
+			{name: "missing filter", err: "filter not found: SELECT FROM 'F'",
+				p: newPlacementPolicy(1, []ReplicaDescriptor{newReplica(1, "X")},
+					[]Selector{newSelector("X", "", 1, "F", (*Selector).SelectDistinct)}, nil)},
+			{name: "invalid filter", err: "process filter #0 (F): invalid number: 'abc'",
+				p: newPlacementPolicy(1, []ReplicaDescriptor{newReplica(1, "X")},
+					[]Selector{newSelector("X", "", 1, "F", (*Selector).SelectDistinct)},
+					[]Filter{newFilter("F", "Capacity", "abc", FilterOpGT)})},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := nm.ExplainPlacement(tc.p, cnr)
+				require.EqualError(t, err, tc.err)
+			})
+		}
+	})
+}
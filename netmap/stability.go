@@ -0,0 +1,214 @@
+package netmap
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// NodeMovement describes data movement from and to particular storage node.
+type NodeMovement struct {
+	// Storage node.
+	Node NodeInfo
+	// Number of object replicas the node must receive.
+	Inbound int
+	// Number of object replicas the node is no longer responsible for.
+	Outbound int
+}
+
+// ObjectMovement describes changes in the set of storage nodes holding object
+// replicas.
+type ObjectMovement struct {
+	// Object ID.
+	ID oid.ID
+	// Nodes that must receive replicas. Node may repeat if it stores replicas
+	// according to several replica descriptors or EC rules.
+	Added []NodeInfo
+	// Nodes that are no longer responsible for replicas. Node may repeat if it
+	// stored replicas according to several replica descriptors or EC rules.
+	Removed []NodeInfo
+}
+
+// PlacementDiff describes the difference between container placements in two
+// network maps. See [ComparePlacement].
+type PlacementDiff struct {
+	// Container nodes from the new network map that are not container nodes in
+	// the previous one. Nodes are ordered as in [NetMap.ContainerNodes] result
+	// for the new network map.
+	AddedContainerNodes []NodeInfo
+	// Container nodes from the previous network map that are not container
+	// nodes in the new one. Nodes are ordered as in [NetMap.ContainerNodes]
+	// result for the previous network map.
+	RemovedContainerNodes []NodeInfo
+	// Objects whose replica holders changed in the order they were passed.
+	Objects []ObjectMovement
+	// Total number of object replicas in the new network map.
+	TotalReplicas int
+	// Number of object replicas that must be placed onto the nodes not storing
+	// them previously.
+	MovedReplicas int
+	// Per-node replica movement sorted by node public keys. Nodes without
+	// movement are not included.
+	Nodes []NodeMovement
+}
+
+// ContainerChanged checks whether container node set changed.
+func (x PlacementDiff) ContainerChanged() bool {
+	return len(x.AddedContainerNodes) > 0 || len(x.RemovedContainerNodes) > 0
+}
+
+// MovedFraction returns fraction of object replicas that must move. Returns 0
+// if there are no replicas.
+func (x PlacementDiff) MovedFraction() float64 {
+	if x.TotalReplicas == 0 {
+		return 0
+	}
+	return float64(x.MovedReplicas) / float64(x.TotalReplicas)
+}
+
+// ComparePlacement applies placement policy of the referenced container to
+// both network maps and reports how the placement changes from prev to next.
+// Container nodes (see [NetMap.ContainerNodes]) are compared always, object
+// replicas are compared for the given objects only, so they can be used as a
+// sample to estimate the amount of data to be moved.
+//
+// Holders of object replicas are the first nodes of the placement vectors (see
+// [NetMap.PlacementVectors]): as many as replica descriptor requires or as many
+// as data and parity parts of the EC rule. Nodes are identified by their
+// public keys. Replica holders are compared as sets, while EC part i is stored
+// on the i-th node of the vector, so EC holders are compared position by
+// position: the same nodes in a different order still mean parts must move.
+//
+// Returns error if any network map is unable to satisfy the policy.
+func ComparePlacement(prev, next NetMap, p PlacementPolicy, cnr cid.ID, objs []oid.ID) (PlacementDiff, error) {
+	var res PlacementDiff
+
+	prevVectors, err := prev.ContainerNodes(p, cnr)
+	if err != nil {
+		return res, fmt.Errorf("apply policy to previous network map: %w", err)
+	}
+	nextVectors, err := next.ContainerNodes(p, cnr)
+	if err != nil {
+		return res, fmt.Errorf("apply policy to next network map: %w", err)
+	}
+
+	prevNodes := uniqueNodes(prevVectors)
+	nextNodes := uniqueNodes(nextVectors)
+	res.AddedContainerNodes = nodesDiff(nextNodes, prevNodes)
+	res.RemovedContainerNodes = nodesDiff(prevNodes, nextNodes)
+
+	limits := make([]int, 0, len(p.replicas)+len(p.ecRules))
+	for i := range p.replicas {
+		limits = append(limits, int(p.replicas[i].NumberOfObjects()))
+	}
+	for i := range p.ecRules {
+		limits = append(limits, int(p.ecRules[i].DataPartNum()+p.ecRules[i].ParityPartNum()))
+	}
+
+	movements := make(map[string]*NodeMovement)
+	movement := func(n NodeInfo) *NodeMovement {
+		m, ok := movements[string(n.PublicKey())]
+		if !ok {
+			m = &NodeMovement{Node: n}
+			movements[string(n.PublicKey())] = m
+		}
+		return m
+	}
+
+	for _, id := range objs {
+		prevObjVectors, err := prev.PlacementVectors(prevVectors, id)
+		if err != nil {
+			return res, fmt.Errorf("sort nodes in previous network map for object %s: %w", id, err)
+		}
+		nextObjVectors, err := next.PlacementVectors(nextVectors, id)
+		if err != nil {
+			return res, fmt.Errorf("sort nodes in next network map for object %s: %w", id, err)
+		}
+
+		om := ObjectMovement{ID: id}
+		for i := range limits {
+			prevHolders := prevObjVectors[i][:min(limits[i], len(prevObjVectors[i]))]
+			nextHolders := nextObjVectors[i][:min(limits[i], len(nextObjVectors[i]))]
+
+			res.TotalReplicas += len(nextHolders)
+
+			var added, removed []NodeInfo
+			if i < len(p.replicas) {
+				added = nodesDiff(nextHolders, prevHolders)
+				removed = nodesDiff(prevHolders, nextHolders)
+			} else {
+				added, removed = nodesPosDiff(prevHolders, nextHolders)
+			}
+			for j := range added {
+				movement(added[j]).Inbound++
+			}
+			for j := range removed {
+				movement(removed[j]).Outbound++
+			}
+
+			res.MovedReplicas += len(added)
+			om.Added = append(om.Added, added...)
+			om.Removed = append(om.Removed, removed...)
+		}
+
+		if len(om.Added) > 0 || len(om.Removed) > 0 {
+			res.Objects = append(res.Objects, om)
+		}
+	}
+
+	res.Nodes = make([]NodeMovement, 0, len(movements))
+	for _, m := range movements {
+		res.Nodes = append(res.Nodes, *m)
+	}
+	slices.SortFunc(res.Nodes, func(a, b NodeMovement) int { return bytes.Compare(a.Node.PublicKey(), b.Node.PublicKey()) })
+
+	return res, nil
+}
+
+// uniqueNodes returns flat list of nodes from vs without duplicates.
+func uniqueNodes(vs [][]NodeInfo) []NodeInfo {
+	var res []NodeInfo
+	for i := range vs {
+		for j := range vs[i] {
+			if !containsNode(res, vs[i][j]) {
+				res = append(res, vs[i][j])
+			}
+		}
+	}
+	return res
+}
+
+// nodesDiff returns nodes from a missing in b.
+func nodesDiff(a, b []NodeInfo) []NodeInfo {
+	var res []NodeInfo
+	for i := range a {
+		if !containsNode(b, a[i]) {
+			res = append(res, a[i])
+		}
+	}
+	return res
+}
+
+// nodesPosDiff compares prev and next position by position and returns nodes
+// from next and prev that differ.
+func nodesPosDiff(prev, next []NodeInfo) (added, removed []NodeInfo) {
+	for i := range max(len(prev), len(next)) {
+		if i < len(prev) && i < len(next) && bytes.Equal(prev[i].PublicKey(), next[i].PublicKey()) {
+			continue
+		}
+		if i < len(next) {
+			added = append(added, next[i])
+		}
+		if i < len(prev) {
+			removed = append(removed, prev[i])
+		}
+	}
+	return added, removed
+}
+
+func containsNode(ns []NodeInfo, n NodeInfo) bool {
+	return slices.ContainsFunc(ns, func(x NodeInfo) bool { return bytes.Equal(x.PublicKey(), n.PublicKey()) })
+}
@@ -0,0 +1,147 @@
+package netmap
+
+import (
+	"bytes"
+	"testing"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlacementDiff_MovedFraction(t *testing.T) {
+	require.Zero(t, PlacementDiff{}.MovedFraction())
+	require.Zero(t, PlacementDiff{TotalReplicas: 10}.MovedFraction())
+	require.EqualValues(t, 0.25, PlacementDiff{TotalReplicas: 8, MovedReplicas: 2}.MovedFraction())
+}
+
+func TestComparePlacement(t *testing.T) {
+	cnr := cid.ID{1, 2, 3}
+	objs := oidtest.IDs(20)
+	p := decodeExplainTestPolicy(t, `REP 2
+EC 2/1`)
+
+	nm := newExplainTestNetMap("RU", "RU", "DE", "DE", "US", "FR")
+
+	t.Run("same network map", func(t *testing.T) {
+		res, err := ComparePlacement(nm, nm, p, cnr, objs)
+		require.NoError(t, err)
+		require.False(t, res.ContainerChanged())
+		require.Empty(t, res.Objects)
+		require.Empty(t, res.Nodes)
+		require.Equal(t, len(objs)*(2+3), res.TotalReplicas)
+		require.Zero(t, res.MovedReplicas)
+		require.Zero(t, res.MovedFraction())
+	})
+
+	t.Run("node removed", func(t *testing.T) {
+		var next NetMap
+		next.SetNodes(nm.nodes[1:])
+
+		res, err := ComparePlacement(nm, next, p, cnr, objs)
+		require.NoError(t, err)
+		require.True(t, res.ContainerChanged())
+		require.Empty(t, res.AddedContainerNodes)
+		require.Equal(t, []NodeInfo{nm.nodes[0]}, res.RemovedContainerNodes)
+		require.Equal(t, len(objs)*(2+3), res.TotalReplicas)
+		require.NotZero(t, res.MovedReplicas)
+		require.Greater(t, res.MovedFraction(), 0.)
+		require.Less(t, res.MovedFraction(), 1.)
+
+		var in, out, removedOut int
+		for i := range res.Nodes {
+			in += res.Nodes[i].Inbound
+			out += res.Nodes[i].Outbound
+			if containsNode([]NodeInfo{nm.nodes[0]}, res.Nodes[i].Node) {
+				removedOut = res.Nodes[i].Outbound
+				require.Zero(t, res.Nodes[i].Inbound)
+			}
+			if i > 0 {
+				require.Negative(t, bytes.Compare(res.Nodes[i-1].Node.PublicKey(), res.Nodes[i].Node.PublicKey()))
+			}
+		}
+		require.Equal(t, res.MovedReplicas, in)
+		require.Equal(t, res.MovedReplicas, out)
+		// the removed node loses all its replicas and EC parts, while EC parts of
+		// other nodes may shift to other positions
+		require.Positive(t, removedOut)
+		require.LessOrEqual(t, removedOut, res.MovedReplicas)
+
+		var added, removed int
+		for i := range res.Objects {
+			require.Contains(t, objs, res.Objects[i].ID)
+			require.True(t, containsNode(res.Objects[i].Removed, nm.nodes[0]))
+			added += len(res.Objects[i].Added)
+			removed += len(res.Objects[i].Removed)
+		}
+		require.Equal(t, res.MovedReplicas, added)
+		require.Equal(t, res.MovedReplicas, removed)
+	})
+
+	t.Run("EC holders reordered", func(t *testing.T) {
+		p := decodeExplainTestPolicy(t, "EC 2/1")
+		prev := newExplainTestNetMap("RU", "DE", "US")
+		next := newExplainTestNetMap("RU", "DE", "US")
+		next.nodes[0] = nodeInfoFromAttributes("Country", "RU", "Price", "1", "Capacity", "1000")
+		next.nodes[0].SetPublicKey(prev.nodes[0].PublicKey())
+
+		res, err := ComparePlacement(prev, next, p, cnr, objs)
+		require.NoError(t, err)
+		require.False(t, res.ContainerChanged())
+		require.Equal(t, len(objs)*3, res.TotalReplicas)
+
+		prevVectors, err := prev.ContainerNodes(p, cnr)
+		require.NoError(t, err)
+		nextVectors, err := next.ContainerNodes(p, cnr)
+		require.NoError(t, err)
+		var moved int
+		for _, id := range objs {
+			prevObj, err := prev.PlacementVectors(prevVectors, id)
+			require.NoError(t, err)
+			nextObj, err := next.PlacementVectors(nextVectors, id)
+			require.NoError(t, err)
+			for i := range nextObj[0] {
+				if !bytes.Equal(prevObj[0][i].PublicKey(), nextObj[0][i].PublicKey()) {
+					moved++
+				}
+			}
+		}
+		require.NotZero(t, moved) // same node set, but parts change their nodes
+		require.Equal(t, moved, res.MovedReplicas)
+
+		var in, out int
+		for i := range res.Nodes {
+			in += res.Nodes[i].Inbound
+			out += res.Nodes[i].Outbound
+		}
+		require.Equal(t, moved, in)
+		require.Equal(t, moved, out)
+	})
+
+	t.Run("without objects", func(t *testing.T) {
+		next := newExplainTestNetMap("RU", "RU", "DE", "DE", "US", "FR", "UK")
+
+		res, err := ComparePlacement(nm, next, p, cnr, nil)
+		require.NoError(t, err)
+		require.True(t, res.ContainerChanged())
+		require.Equal(t, []NodeInfo{next.nodes[6]}, res.AddedContainerNodes)
+		require.Empty(t, res.RemovedContainerNodes)
+		require.Empty(t, res.Objects)
+		require.Empty(t, res.Nodes)
+		require.Zero(t, res.TotalReplicas)
+		require.Zero(t, res.MovedReplicas)
+	})
+
+	t.Run("unsatisfiable", func(t *testing.T) {
+		var small NetMap
+		small.SetNodes(nm.nodes[:2])
+
+		_, err := ComparePlacement(small, nm, p, cnr, []oid.ID{objs[0]})
+		require.ErrorIs(t, err, ErrNotEnoughNodes)
+		require.EqualError(t, err, "apply policy to previous network map: not enough nodes to SELECT from: ''")
+		_, err = ComparePlacement(nm, small, p, cnr, []oid.ID{objs[0]})
+		require.ErrorIs(t, err, ErrNotEnoughNodes)
+		require.EqualError(t, err, "apply policy to next network map: not enough nodes to SELECT from: ''")
+	})
+}
@@ -0,0 +1,16 @@
+/*
+Package ec provides erasure coding of NeoFS object payloads.
+
+Containers with [netmap.ECRule] in their storage policy store objects as a set
+of EC parts: payload of the original (parent) object is split into
+[netmap.ECRule.DataPartNum] data parts of equal size, and
+[netmap.ECRule.ParityPartNum] parity parts are calculated for them using
+Reed-Solomon code. Any DataPartNum parts are enough to restore the original
+payload.
+
+[Encode] and [Decode] work with raw payloads, while [FormParts] and
+[Reconstruct] operate with complete NeoFS objects carrying EC part attributes
+([object.AttributeECRuleIndex] and [object.AttributeECPartIndex]) and
+referencing the parent object header.
+*/
+package ec
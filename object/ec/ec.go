@@ -0,0 +1,104 @@
+package ec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/klauspost/reedsolomon"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// ErrNotEnoughParts is returned when there are not enough parts to restore
+// the data.
+var ErrNotEnoughParts = errors.New("not enough parts")
+
+// newCoder constructs Reed-Solomon coder for the given rule.
+func newCoder(rule netmap.ECRule) (reedsolomon.Encoder, error) {
+	if rule.DataPartNum() == 0 {
+		return nil, errors.New("zero data part num")
+	}
+	if rule.ParityPartNum() == 0 {
+		return nil, errors.New("zero parity part num")
+	}
+	enc, err := reedsolomon.New(int(rule.DataPartNum()), int(rule.ParityPartNum()))
+	if err != nil {
+		return nil, fmt.Errorf("init Reed-Solomon coder: %w", err)
+	}
+	return enc, nil
+}
+
+// Encode encodes data according to the given rule and returns
+// [netmap.ECRule.DataPartNum] + [netmap.ECRule.ParityPartNum] parts. First
+// DataPartNum elements are data parts, the rest are parity ones. All parts have
+// the same length; the last data part is zero-padded if needed. Empty data
+// results in empty parts.
+//
+// Data parts may share memory with data, so data must not be changed while
+// parts are in use.
+func Encode(rule netmap.ECRule, data []byte) ([][]byte, error) {
+	enc, err := newCoder(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return make([][]byte, rule.DataPartNum()+rule.ParityPartNum()), nil
+	}
+
+	// clip capacity to prevent the coder from writing into the data's tail
+	parts, err := enc.Split(slices.Clip(data))
+	if err != nil {
+		return nil, fmt.Errorf("split data: %w", err)
+	}
+
+	if err = enc.Encode(parts); err != nil {
+		return nil, fmt.Errorf("calculate parity: %w", err)
+	}
+
+	return parts, nil
+}
+
+// Decode restores data of dataLen bytes from the parts produced by [Encode]
+// with the same rule. Parts must be ordered as returned by Encode, missing ones
+// must be nil or empty. At least [netmap.ECRule.DataPartNum] parts must be
+// present, otherwise [ErrNotEnoughParts] is returned.
+//
+// Decode restores missing data parts in the parts slice.
+func Decode(rule netmap.ECRule, dataLen uint64, parts [][]byte) ([]byte, error) {
+	enc, err := newCoder(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int(rule.DataPartNum() + rule.ParityPartNum())
+	if len(parts) != total {
+		return nil, fmt.Errorf("wrong number of parts: expected %d, got %d", total, len(parts))
+	}
+
+	if dataLen == 0 {
+		return []byte{}, nil
+	}
+
+	var present int
+	for i := range parts {
+		if len(parts[i]) > 0 {
+			present++
+		}
+	}
+	if present < int(rule.DataPartNum()) {
+		return nil, fmt.Errorf("%w: need %d, got %d", ErrNotEnoughParts, rule.DataPartNum(), present)
+	}
+
+	if err = enc.ReconstructData(parts); err != nil {
+		return nil, fmt.Errorf("reconstruct data parts: %w", err)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, dataLen))
+	if err = enc.Join(buf, parts, int(dataLen)); err != nil {
+		return nil, fmt.Errorf("join data parts: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,321 @@
+package ec_test
+
+import (
+	"strconv"
+	"testing"
+
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/testutil"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object/ec"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+// forEachSubset calls f for each subset of [0, n) of size k.
+func forEachSubset(n, k int, f func([]int)) {
+	var rec func(start int, cur []int)
+	rec = func(start int, cur []int) {
+		if len(cur) == k {
+			f(cur)
+			return
+		}
+		for i := start; i < n; i++ {
+			rec(i+1, append(cur, i))
+		}
+	}
+	rec(0, nil)
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("invalid rule", func(t *testing.T) {
+		_, err := ec.Encode(netmap.NewECRule(0, 1), []byte("Hello"))
+		require.EqualError(t, err, "zero data part num")
+		_, err = ec.Encode(netmap.NewECRule(1, 0), []byte("Hello"))
+		require.EqualError(t, err, "zero parity part num")
+		_, err = ec.Encode(netmap.NewECRule(1<<16, 1), []byte("Hello"))
+		require.ErrorContains(t, err, "init Reed-Solomon coder")
+	})
+	t.Run("empty", func(t *testing.T) {
+		parts, err := ec.Encode(netmap.NewECRule(3, 2), nil)
+		require.NoError(t, err)
+		require.Len(t, parts, 5)
+		for i := range parts {
+			require.Empty(t, parts[i])
+		}
+	})
+	t.Run("data parts", func(t *testing.T) {
+		data := []byte("Hello, world!")
+		parts, err := ec.Encode(netmap.NewECRule(3, 2), data)
+		require.NoError(t, err)
+		require.Len(t, parts, 5)
+		for i := range parts {
+			require.Len(t, parts[i], 5)
+		}
+		require.Equal(t, []byte("Hello"), parts[0])
+		require.Equal(t, []byte(", wor"), parts[1])
+		require.Equal(t, []byte("ld!\x00\x00"), parts[2])
+	})
+	t.Run("data tail", func(t *testing.T) {
+		buf := make([]byte, 10, 100)
+		copy(buf, "0123456789")
+		tail := testutil.RandByteSlice(90)
+		copy(buf[10:100], tail)
+		_, err := ec.Encode(netmap.NewECRule(3, 2), buf)
+		require.NoError(t, err)
+		require.Equal(t, tail, buf[10:100])
+	})
+}
+
+func TestDecode(t *testing.T) {
+	for _, tc := range []struct{ data, parity uint32 }{
+		{1, 1}, {2, 1}, {3, 2}, {4, 4}, {6, 3},
+	} {
+		rule := netmap.NewECRule(tc.data, tc.parity)
+		total := int(tc.data + tc.parity)
+		for _, ln := range []int{1, 2, 7, 1 << 10, 1<<10 + 1} {
+			t.Run(strconv.Itoa(int(tc.data))+"+"+strconv.Itoa(int(tc.parity))+"/"+strconv.Itoa(ln), func(t *testing.T) {
+				data := testutil.RandByteSlice(ln)
+				orig, err := ec.Encode(rule, data)
+				require.NoError(t, err)
+
+				forEachSubset(total, int(tc.data), func(survived []int) {
+					parts := make([][]byte, total)
+					for _, i := range survived {
+						parts[i] = append([]byte{}, orig[i]...)
+					}
+					res, err := ec.Decode(rule, uint64(ln), parts)
+					require.NoError(t, err, survived)
+					require.Equal(t, data, res, survived)
+				})
+
+				forEachSubset(total, int(tc.data)-1, func(survived []int) {
+					parts := make([][]byte, total)
+					for _, i := range survived {
+						parts[i] = orig[i]
+					}
+					_, err := ec.Decode(rule, uint64(ln), parts)
+					require.ErrorIs(t, err, ec.ErrNotEnoughParts)
+				})
+			})
+		}
+	}
+	t.Run("empty", func(t *testing.T) {
+		res, err := ec.Decode(netmap.NewECRule(3, 2), 0, make([][]byte, 5))
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+	t.Run("wrong number of parts", func(t *testing.T) {
+		_, err := ec.Decode(netmap.NewECRule(3, 2), 10, make([][]byte, 4))
+		require.EqualError(t, err, "wrong number of parts: expected 5, got 4")
+	})
+	t.Run("different part sizes", func(t *testing.T) {
+		parts, err := ec.Encode(netmap.NewECRule(3, 2), testutil.RandByteSlice(100))
+		require.NoError(t, err)
+		parts[0] = nil
+		parts[4] = parts[4][1:]
+		_, err = ec.Decode(netmap.NewECRule(3, 2), 100, parts)
+		require.ErrorContains(t, err, "reconstruct data parts")
+	})
+}
+
+func newParent(t testing.TB, payloadLen int) object.Object {
+	obj := object.New(cidtest.ID(), usertest.ID())
+	obj.SetCreationEpoch(13)
+	obj.SetAttributes(object.NewAttribute("k", "v"))
+	obj.SetPayload(testutil.RandByteSlice(payloadLen))
+	obj.SetPayloadSize(uint64(payloadLen))
+	require.NoError(t, obj.SetVerificationFields(usertest.User()))
+	return *obj
+}
+
+func TestGetPartInfo(t *testing.T) {
+	var obj object.Object
+	_, err := ec.GetPartInfo(obj)
+	require.ErrorIs(t, err, ec.ErrNotPart)
+
+	obj.SetAttributes(object.NewAttribute(object.AttributeECRuleIndex, "1"))
+	_, err = ec.GetPartInfo(obj)
+	require.ErrorIs(t, err, ec.ErrNotPart)
+
+	obj.SetAttributes(object.NewAttribute(object.AttributeECPartIndex, "2"))
+	_, err = ec.GetPartInfo(obj)
+	require.ErrorIs(t, err, ec.ErrNotPart)
+
+	obj.SetAttributes(
+		object.NewAttribute(object.AttributeECRuleIndex, "1"),
+		object.NewAttribute(object.AttributeECPartIndex, "2"),
+	)
+	info, err := ec.GetPartInfo(obj)
+	require.NoError(t, err)
+	require.Equal(t, ec.PartInfo{RuleIndex: 1, Index: 2}, info)
+
+	for _, tc := range []struct{ name, rule, part, err string }{
+		{name: "rule/not a number", rule: "x", part: "1", err: "invalid __NEOFS__EC_RULE_IDX attribute: strconv.Atoi: parsing \"x\": invalid syntax"},
+		{name: "rule/negative", rule: "-1", part: "1", err: "invalid __NEOFS__EC_RULE_IDX attribute: negative value -1"},
+		{name: "part/not a number", rule: "1", part: "x", err: "invalid __NEOFS__EC_PART_IDX attribute: strconv.Atoi: parsing \"x\": invalid syntax"},
+		{name: "part/negative", rule: "1", part: "-1", err: "invalid __NEOFS__EC_PART_IDX attribute: negative value -1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj.SetAttributes(
+				object.NewAttribute(object.AttributeECRuleIndex, tc.rule),
+				object.NewAttribute(object.AttributeECPartIndex, tc.part),
+			)
+			_, err := ec.GetPartInfo(obj)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestFormParts(t *testing.T) {
+	rule := netmap.NewECRule(3, 2)
+	parent := newParent(t, 1000)
+	signer := neofscryptotest.Signer()
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ec.FormParts(signer, parent, -1, rule)
+		require.EqualError(t, err, "negative rule index -1")
+
+		noID := parent
+		noID.ResetID()
+		_, err = ec.FormParts(signer, noID, 0, rule)
+		require.EqualError(t, err, "missing parent ID")
+
+		cut := *parent.CutPayload()
+		_, err = ec.FormParts(signer, cut, 0, rule)
+		require.EqualError(t, err, "parent payload length 0 mismatches declared size 1000")
+
+		_, err = ec.FormParts(signer, parent, 0, netmap.NewECRule(0, 1))
+		require.EqualError(t, err, "encode payload: zero data part num")
+
+		_, err = ec.FormParts(neofscryptotest.FailSigner(signer), parent, 0, rule)
+		require.ErrorContains(t, err, "sign part #0: ")
+	})
+
+	for _, signed := range []bool{true, false} {
+		t.Run("signed="+strconv.FormatBool(signed), func(t *testing.T) {
+			s := signer.Signer
+			if !signed {
+				s = nil
+			}
+			parts, err := ec.FormParts(s, parent, 2, rule)
+			require.NoError(t, err)
+			require.Len(t, parts, 5)
+
+			for i := range parts {
+				require.Equal(t, parent.GetContainerID(), parts[i].GetContainerID())
+				require.Equal(t, parent.Owner(), parts[i].Owner())
+				require.Equal(t, parent.CreationEpoch(), parts[i].CreationEpoch())
+				require.Equal(t, parent.Version(), parts[i].Version())
+				require.Equal(t, object.TypeRegular, parts[i].Type())
+				require.EqualValues(t, 334, parts[i].PayloadSize())
+				require.NoError(t, parts[i].VerifyPayloadChecksum())
+				require.NoError(t, parts[i].VerifyID())
+				require.Equal(t, signed, parts[i].VerifySignature())
+				if !signed {
+					require.Error(t, parts[i].CheckVerificationFields()) // caller must sign
+				}
+
+				par := parts[i].Parent()
+				require.NotNil(t, par)
+				require.Equal(t, parent.GetID(), par.GetID())
+				require.Equal(t, parent.Signature(), par.Signature())
+				require.Equal(t, *parent.CutPayload(), *par)
+
+				info, err := ec.GetPartInfo(parts[i])
+				require.NoError(t, err)
+				require.Equal(t, ec.PartInfo{RuleIndex: 2, Index: i}, info)
+			}
+		})
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	rule := netmap.NewECRule(3, 2)
+
+	for _, ln := range []int{0, 1, 1000} {
+		t.Run(strconv.Itoa(ln), func(t *testing.T) {
+			parent := newParent(t, ln)
+			parts, err := ec.FormParts(nil, parent, 0, rule)
+			require.NoError(t, err)
+
+			forEachSubset(len(parts), int(rule.DataPartNum()), func(survived []int) {
+				var in []object.Object
+				for i := len(survived) - 1; i >= 0; i-- { // order must not matter
+					in = append(in, parts[survived[i]])
+				}
+				res, err := ec.Reconstruct(rule, in)
+				require.NoError(t, err, survived)
+				require.Equal(t, parent.GetID(), res.GetID())
+				require.Equal(t, parent.Payload(), res.Payload())
+				require.NoError(t, res.CheckVerificationFields())
+			})
+		})
+	}
+
+	parent := newParent(t, 1000)
+	parts, err := ec.FormParts(nil, parent, 1, rule)
+	require.NoError(t, err)
+
+	t.Run("no parts", func(t *testing.T) {
+		_, err := ec.Reconstruct(rule, nil)
+		require.ErrorIs(t, err, ec.ErrNotEnoughParts)
+	})
+	t.Run("not enough parts", func(t *testing.T) {
+		_, err := ec.Reconstruct(rule, parts[3:])
+		require.ErrorIs(t, err, ec.ErrNotEnoughParts)
+	})
+	t.Run("not a part", func(t *testing.T) {
+		_, err := ec.Reconstruct(rule, []object.Object{parts[0], parent})
+		require.ErrorIs(t, err, ec.ErrNotPart)
+		require.ErrorContains(t, err, "part #1")
+	})
+	t.Run("index out of range", func(t *testing.T) {
+		p := parts[0]
+		p.SetAttributes(
+			object.NewAttribute(object.AttributeECRuleIndex, "1"),
+			object.NewAttribute(object.AttributeECPartIndex, "5"),
+		)
+		_, err := ec.Reconstruct(rule, []object.Object{p})
+		require.EqualError(t, err, "part #0: index 5 is out of range [0, 5)")
+	})
+	t.Run("missing parent", func(t *testing.T) {
+		p := parts[0]
+		p.SetParent(nil)
+		_, err := ec.Reconstruct(rule, []object.Object{p})
+		require.EqualError(t, err, "part #0: missing parent header")
+	})
+	t.Run("different parents", func(t *testing.T) {
+		other, err := ec.FormParts(nil, newParent(t, 1000), 1, rule)
+		require.NoError(t, err)
+		_, err = ec.Reconstruct(rule, []object.Object{parts[0], other[1], parts[2]})
+		require.ErrorContains(t, err, "part #1: parent ID")
+	})
+	t.Run("different rules", func(t *testing.T) {
+		other, err := ec.FormParts(nil, parent, 0, rule)
+		require.NoError(t, err)
+		_, err = ec.Reconstruct(rule, []object.Object{parts[0], other[1], parts[2]})
+		require.EqualError(t, err, "part #1: rule index 0 differs from 1")
+	})
+	t.Run("duplicated index", func(t *testing.T) {
+		_, err := ec.Reconstruct(rule, []object.Object{parts[0], parts[1], parts[0]})
+		require.EqualError(t, err, "part #2: duplicated index 0")
+	})
+	t.Run("corrupted payload", func(t *testing.T) {
+		p := parts[1]
+		p.SetPayload(testutil.RandByteSlice(len(p.Payload())))
+		_, err := ec.Reconstruct(rule, []object.Object{parts[0], p, parts[2]})
+		require.EqualError(t, err, "part #1: payload checksum mismatch")
+	})
+	t.Run("wrong parent checksum", func(t *testing.T) {
+		par := newParent(t, 1000)
+		par.SetPayloadChecksum(object.CalculatePayloadChecksum([]byte("other")))
+		require.NoError(t, par.SetIDWithSignature(usertest.User()))
+		parts, err := ec.FormParts(nil, par, 0, rule)
+		require.NoError(t, err)
+		_, err = ec.Reconstruct(rule, parts)
+		require.EqualError(t, err, "restored object: payload checksum mismatch")
+	})
+}
@@ -0,0 +1,180 @@
+package ec
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// ErrNotPart is returned when object has no EC part attributes.
+var ErrNotPart = errors.New("not an EC part")
+
+// PartInfo groups EC part attributes of the object.
+type PartInfo struct {
+	// RuleIndex is an index of the EC rule in the container storage policy
+	// according to which the part was created.
+	RuleIndex int
+	// Index is an index of the part in the list returned by [Encode].
+	Index int
+}
+
+// GetPartInfo reads EC part attributes of the object. Returns [ErrNotPart] if
+// any of [object.AttributeECRuleIndex] and [object.AttributeECPartIndex] is
+// missing.
+func GetPartInfo(obj object.Object) (PartInfo, error) {
+	var (
+		res                  PartInfo
+		ruleFound, partFound bool
+		err                  error
+	)
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case object.AttributeECRuleIndex:
+			if res.RuleIndex, err = strconv.Atoi(a.Value()); err != nil {
+				return PartInfo{}, fmt.Errorf("invalid %s attribute: %w", object.AttributeECRuleIndex, err)
+			}
+			if res.RuleIndex < 0 {
+				return PartInfo{}, fmt.Errorf("invalid %s attribute: negative value %d", object.AttributeECRuleIndex, res.RuleIndex)
+			}
+			ruleFound = true
+		case object.AttributeECPartIndex:
+			if res.Index, err = strconv.Atoi(a.Value()); err != nil {
+				return PartInfo{}, fmt.Errorf("invalid %s attribute: %w", object.AttributeECPartIndex, err)
+			}
+			if res.Index < 0 {
+				return PartInfo{}, fmt.Errorf("invalid %s attribute: negative value %d", object.AttributeECPartIndex, res.Index)
+			}
+			partFound = true
+		}
+	}
+	if !ruleFound || !partFound {
+		return PartInfo{}, ErrNotPart
+	}
+	return res, nil
+}
+
+// FormParts encodes payload of the parent object according to the rule with
+// index ruleIdx in the container storage policy and returns ready-to-store
+// part objects ordered by part index. Parent object must have full payload and
+// calculated ID.
+//
+// Each part is a regular object of the parent's container with the parent
+// header (without payload) attached, [object.AttributeECRuleIndex] and
+// [object.AttributeECPartIndex] attributes, payload checksum and ID. If signer
+// is set, parts are signed by it. Otherwise, parts are left unsigned and the
+// caller must sign them before storing, e.g. using [object.Object.Sign].
+func FormParts(signer neofscrypto.Signer, parent object.Object, ruleIdx int, rule netmap.ECRule) ([]object.Object, error) {
+	if ruleIdx < 0 {
+		return nil, fmt.Errorf("negative rule index %d", ruleIdx)
+	}
+	if parent.GetID().IsZero() {
+		return nil, errors.New("missing parent ID")
+	}
+	if uint64(len(parent.Payload())) != parent.PayloadSize() {
+		return nil, fmt.Errorf("parent payload length %d mismatches declared size %d", len(parent.Payload()), parent.PayloadSize())
+	}
+
+	payloads, err := Encode(rule, parent.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("encode payload: %w", err)
+	}
+
+	hdr := parent.CutPayload()
+	ruleIdxAttr := object.NewAttribute(object.AttributeECRuleIndex, strconv.Itoa(ruleIdx))
+	res := make([]object.Object, len(payloads))
+	for i := range payloads {
+		res[i].SetVersion(parent.Version())
+		res[i].SetContainerID(parent.GetContainerID())
+		res[i].SetOwner(parent.Owner())
+		res[i].SetCreationEpoch(parent.CreationEpoch())
+		res[i].SetType(object.TypeRegular)
+		res[i].SetSessionToken(parent.SessionToken())
+		res[i].SetSessionTokenV2(parent.SessionTokenV2())
+		res[i].SetParent(hdr)
+		res[i].SetAttributes(ruleIdxAttr, object.NewAttribute(object.AttributeECPartIndex, strconv.Itoa(i)))
+		res[i].SetPayload(payloads[i])
+		res[i].SetPayloadSize(uint64(len(payloads[i])))
+		res[i].CalculateAndSetPayloadChecksum()
+		if err = res[i].CalculateAndSetID(); err != nil {
+			return nil, fmt.Errorf("calculate ID of part #%d: %w", i, err)
+		}
+		if signer != nil {
+			if err = res[i].Sign(signer); err != nil {
+				return nil, fmt.Errorf("sign part #%d: %w", i, err)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Reconstruct restores parent object with full payload from its EC parts
+// formed according to the given rule. Parts may be passed in any order, at
+// least [netmap.ECRule.DataPartNum] of them are required, otherwise
+// [ErrNotEnoughParts] is returned. All parts must belong to the same parent
+// object and EC rule and have correct payload checksums. Payload checksum of
+// the resulting object is verified if set.
+func Reconstruct(rule netmap.ECRule, parts []object.Object) (object.Object, error) {
+	if len(parts) == 0 {
+		return object.Object{}, ErrNotEnoughParts
+	}
+
+	total := int(rule.DataPartNum() + rule.ParityPartNum())
+	payloads := make([][]byte, total)
+	var (
+		parent  *object.Object
+		ruleIdx int
+	)
+	for i := range parts {
+		info, err := GetPartInfo(parts[i])
+		if err != nil {
+			return object.Object{}, fmt.Errorf("part #%d: %w", i, err)
+		}
+		if info.Index >= total {
+			return object.Object{}, fmt.Errorf("part #%d: index %d is out of range [0, %d)", i, info.Index, total)
+		}
+		par := parts[i].Parent()
+		if par == nil {
+			return object.Object{}, fmt.Errorf("part #%d: missing parent header", i)
+		}
+		if parent == nil {
+			parent, ruleIdx = par, info.RuleIndex
+		} else {
+			if par.GetID() != parent.GetID() {
+				return object.Object{}, fmt.Errorf("part #%d: parent ID %s differs from %s", i, par.GetID(), parent.GetID())
+			}
+			if info.RuleIndex != ruleIdx {
+				return object.Object{}, fmt.Errorf("part #%d: rule index %d differs from %d", i, info.RuleIndex, ruleIdx)
+			}
+		}
+		if payloads[info.Index] != nil {
+			return object.Object{}, fmt.Errorf("part #%d: duplicated index %d", i, info.Index)
+		}
+		if err = parts[i].VerifyPayloadChecksum(); err != nil {
+			return object.Object{}, fmt.Errorf("part #%d: %w", i, err)
+		}
+		payloads[info.Index] = parts[i].Payload()
+		if payloads[info.Index] == nil {
+			payloads[info.Index] = []byte{}
+		}
+	}
+
+	payload, err := Decode(rule, parent.PayloadSize(), payloads)
+	if err != nil {
+		return object.Object{}, fmt.Errorf("decode payload: %w", err)
+	}
+
+	res := *parent
+	res.SetPayload(payload)
+	if _, ok := res.PayloadChecksum(); ok {
+		if err = res.VerifyPayloadChecksum(); err != nil {
+			return object.Object{}, fmt.Errorf("restored object: %w", err)
+		}
+	}
+
+	return res, nil
+}
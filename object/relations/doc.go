@@ -3,5 +3,8 @@ Package relations provides feature to process inner object structure.
 
 Relations is an interface of entity that can receive object header or
 the information about the object relations.
+
+Reader provides random access to the payload of the split object reading its
+parts concurrently.
 */
 package relations
@@ -0,0 +1,346 @@
+package relations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+const (
+	defaultReaderConcurrency = 4
+	defaultReaderChunkSize   = 1 << 20
+)
+
+// RangeExecutor describes methods to read object headers and payload ranges.
+type RangeExecutor interface {
+	HeadExecutor
+	ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm client.PrmObjectRange) (*client.ObjectRangeReader, error)
+}
+
+// ReaderOptions groups optional parameters of [NewReader].
+type ReaderOptions struct {
+	concurrency int
+	chunkSize   uint64
+	readAhead   int
+	noReadAhead bool
+}
+
+// SetConcurrency limits the number of concurrent requests. Defaults to 4.
+func (x *ReaderOptions) SetConcurrency(n int) {
+	x.concurrency = n
+}
+
+// SetChunkSize limits the payload range requested at once. Defaults to 1MB.
+func (x *ReaderOptions) SetChunkSize(size uint64) {
+	x.chunkSize = size
+}
+
+// SetReadAhead specifies the number of chunks (see [ReaderOptions.SetChunkSize])
+// [Reader.Read] requests in advance following the current one. Defaults to the
+// concurrency limit. Zero n disables read-ahead.
+func (x *ReaderOptions) SetReadAhead(n int) {
+	x.readAhead = n
+	x.noReadAhead = n <= 0
+}
+
+// Reader provides random access to the payload of the object split into
+// several parts. Reader implements [io.ReaderAt] and [io.ReadSeeker], so it can
+// be used in particular with [http.ServeContent]. Payload ranges of the parts
+// are requested concurrently, and [Reader.Read] also reads ahead.
+//
+// ReadAt is safe for concurrent use, other methods are not.
+//
+// Reader must be created using [NewReader] and closed after use.
+type Reader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// reads len(buf) bytes of the part payload from the given offset
+	getRange func(ctx context.Context, id oid.ID, off uint64, buf []byte) error
+
+	parts []oid.ID
+	// payload offsets of the parts, the last element is the payload size
+	offsets []uint64
+
+	chunkSize uint64
+	readAhead int
+	// limits the number of concurrent requests
+	sem chan struct{}
+
+	pos    int64
+	chunks map[uint64]*readerChunk
+}
+
+// readerChunk is a payload chunk requested by Read.
+type readerChunk struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	buf    []byte
+	err    error
+}
+
+// NewReader constructs Reader of the object consisting of the given parts
+// which must be in the split chain order (see [Get]). If the object is not
+// split, parts should contain its ID only. Payload sizes of the parts are
+// requested from their headers. Context is used for all requests until the
+// Reader is closed.
+func NewReader(ctx context.Context, executor RangeExecutor, containerID cid.ID, parts []oid.ID, tokens Tokens, signer user.Signer, opts ReaderOptions) (*Reader, error) {
+	if len(parts) == 0 {
+		return nil, errors.New("no parts")
+	}
+
+	if opts.concurrency <= 0 {
+		opts.concurrency = defaultReaderConcurrency
+	}
+	if opts.chunkSize == 0 {
+		opts.chunkSize = defaultReaderChunkSize
+	}
+	if opts.noReadAhead {
+		opts.readAhead = 0
+	} else if opts.readAhead == 0 {
+		opts.readAhead = opts.concurrency
+	}
+
+	var prmHead client.PrmObjectHead
+	var prmRange client.PrmObjectRange
+	if tokens.Bearer != nil {
+		prmHead.WithBearerToken(*tokens.Bearer)
+		prmRange.WithBearerToken(*tokens.Bearer)
+	}
+	if tokens.Session != nil {
+		prmHead.WithinSession(*tokens.Session)
+		prmRange.WithinSession(*tokens.Session)
+	}
+
+	res := &Reader{
+		getRange: func(ctx context.Context, id oid.ID, off uint64, buf []byte) error {
+			rdr, err := executor.ObjectRangeInit(ctx, containerID, id, off, uint64(len(buf)), signer, prmRange)
+			if err != nil {
+				return err
+			}
+			if _, err = io.ReadFull(rdr, buf); err != nil {
+				_ = rdr.Close()
+				return err
+			}
+			return rdr.Close()
+		},
+		parts:     parts,
+		offsets:   make([]uint64, len(parts)+1),
+		chunkSize: opts.chunkSize,
+		readAhead: opts.readAhead,
+		sem:       make(chan struct{}, opts.concurrency),
+		chunks:    make(map[uint64]*readerChunk),
+	}
+
+	sizes := make([]uint64, len(parts))
+	err := res.forEach(ctx, len(parts), func(ctx context.Context, i int) error {
+		hdr, err := executor.ObjectHead(ctx, containerID, parts[i], signer, prmHead)
+		if err != nil {
+			return fmt.Errorf("header of part #%d (%s): %w", i, parts[i], err)
+		}
+		sizes[i] = hdr.PayloadSize()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sizes {
+		res.offsets[i+1] = res.offsets[i] + sizes[i]
+	}
+
+	res.ctx, res.cancel = context.WithCancel(ctx)
+
+	return res, nil
+}
+
+// Size returns full payload size.
+func (x *Reader) Size() int64 {
+	return int64(x.offsets[len(x.offsets)-1])
+}
+
+// forEach calls f for [0, n) concurrently respecting the concurrency limit.
+// Returns the first error, other calls are cancelled.
+func (x *Reader) forEach(ctx context.Context, n int, f func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+loop:
+	for i := range n {
+		select {
+		case <-ctx.Done():
+			break loop
+		case x.sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() { <-x.sem; wg.Done() }()
+			if err := f(ctx, i); err != nil {
+				errOnce.Do(func() { firstErr = err; cancel() })
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// readRange reads len(buf) bytes of the payload starting from off.
+func (x *Reader) readRange(ctx context.Context, off uint64, buf []byte) error {
+	type segment struct {
+		part     int
+		partOff  uint64
+		from, to int
+	}
+
+	var segments []segment
+	part := sort.Search(len(x.parts), func(i int) bool { return x.offsets[i+1] > off })
+	for n := 0; n < len(buf); part++ {
+		partOff := off + uint64(n) - x.offsets[part]
+		ln := min(x.offsets[part+1]-x.offsets[part]-partOff, uint64(len(buf)-n))
+		for ln > 0 {
+			segLn := min(ln, x.chunkSize)
+			segments = append(segments, segment{part: part, partOff: partOff, from: n, to: n + int(segLn)})
+			partOff += segLn
+			n += int(segLn)
+			ln -= segLn
+		}
+	}
+
+	return x.forEach(ctx, len(segments), func(ctx context.Context, i int) error {
+		s := segments[i]
+		if err := x.getRange(ctx, x.parts[s.part], s.partOff, buf[s.from:s.to]); err != nil {
+			return fmt.Errorf("read range [%d:%d] of part #%d (%s): %w",
+				s.partOff, s.partOff+uint64(s.to-s.from), s.part, x.parts[s.part], err)
+		}
+		return nil
+	})
+}
+
+// ReadAt implements [io.ReaderAt].
+func (x *Reader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	size := x.Size()
+	if off >= size {
+		return 0, io.EOF
+	}
+
+	n := int(min(int64(len(p)), size-off))
+	if err := x.readRange(x.ctx, uint64(off), p[:n]); err != nil {
+		return 0, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek implements [io.Seeker].
+func (x *Reader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += x.pos
+	case io.SeekEnd:
+		offset += x.Size()
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	x.pos = offset
+	return offset, nil
+}
+
+// Read implements [io.Reader].
+func (x *Reader) Read(p []byte) (int, error) {
+	size := x.Size()
+	if x.pos >= size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	idx := uint64(x.pos) / x.chunkSize
+	x.prefetch(idx, uint64(size))
+
+	c := x.chunks[idx]
+	select {
+	case <-x.ctx.Done():
+		return 0, x.ctx.Err()
+	case <-c.done:
+	}
+	if c.err != nil {
+		delete(x.chunks, idx)
+		return 0, c.err
+	}
+
+	n := copy(p, c.buf[uint64(x.pos)-idx*x.chunkSize:])
+	x.pos += int64(n)
+	if uint64(x.pos) >= (idx+1)*x.chunkSize {
+		c.cancel()
+		delete(x.chunks, idx)
+	}
+
+	return n, nil
+}
+
+// prefetch makes sure chunks [idx, idx+readAhead] are requested and cancels
+// other ones.
+func (x *Reader) prefetch(idx, size uint64) {
+	last := min(idx+uint64(x.readAhead), (size-1)/x.chunkSize)
+
+	for i, c := range x.chunks {
+		if i < idx || i > last {
+			c.cancel()
+			delete(x.chunks, i)
+		}
+	}
+
+	for i := idx; i <= last; i++ {
+		if _, ok := x.chunks[i]; ok {
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(x.ctx)
+		c := &readerChunk{
+			cancel: cancel,
+			done:   make(chan struct{}),
+			buf:    make([]byte, min(x.chunkSize, size-i*x.chunkSize)),
+		}
+		x.chunks[i] = c
+
+		go func(off uint64) {
+			c.err = x.readRange(ctx, off, c.buf)
+			close(c.done)
+		}(i * x.chunkSize)
+	}
+}
+
+// Close cancels all pending requests. Reader must not be used after Close.
+func (x *Reader) Close() error {
+	x.cancel()
+	clear(x.chunks)
+	return nil
+}
@@ -0,0 +1,241 @@
+package relations
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/testutil"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type partsStorage struct {
+	parts   map[oid.ID][]byte
+	headErr error
+
+	mtx            sync.Mutex
+	active, maxAct int
+	ranges         atomic.Int32
+	rangeErr       error
+	delay          time.Duration
+}
+
+func (x *partsStorage) ObjectHead(_ context.Context, _ cid.ID, id oid.ID, _ user.Signer, _ client.PrmObjectHead) (*object.Object, error) {
+	if x.headErr != nil {
+		return nil, x.headErr
+	}
+	var hdr object.Object
+	hdr.SetPayloadSize(uint64(len(x.parts[id])))
+	return &hdr, nil
+}
+
+func (x *partsStorage) ObjectRangeInit(context.Context, cid.ID, oid.ID, uint64, uint64, user.Signer, client.PrmObjectRange) (*client.ObjectRangeReader, error) {
+	panic("must not be called")
+}
+
+func (x *partsStorage) getRange(_ context.Context, id oid.ID, off uint64, buf []byte) error {
+	x.mtx.Lock()
+	x.active++
+	x.maxAct = max(x.maxAct, x.active)
+	x.mtx.Unlock()
+	defer func() {
+		x.mtx.Lock()
+		x.active--
+		x.mtx.Unlock()
+	}()
+
+	x.ranges.Add(1)
+	time.Sleep(x.delay)
+	if x.rangeErr != nil {
+		return x.rangeErr
+	}
+	copy(buf, x.parts[id][off:])
+	return nil
+}
+
+func newTestReader(t *testing.T, sizes []int, opts ReaderOptions) (*Reader, *partsStorage, []byte) {
+	storage := &partsStorage{parts: make(map[oid.ID][]byte)}
+	ids := oidtest.IDs(len(sizes))
+	var full []byte
+	for i := range sizes {
+		storage.parts[ids[i]] = testutil.RandByteSlice(uint64(sizes[i]))
+		full = append(full, storage.parts[ids[i]]...)
+	}
+
+	r, err := NewReader(context.Background(), storage, cidtest.ID(), ids, Tokens{}, usertest.User(), opts)
+	require.NoError(t, err)
+	r.getRange = storage.getRange
+	t.Cleanup(func() { _ = r.Close() })
+
+	return r, storage, full
+}
+
+func TestReader(t *testing.T) {
+	sizes := []int{1000, 1, 0, 2500, 999}
+
+	t.Run("iotest", func(t *testing.T) {
+		for _, chunkSize := range []uint64{0, 7, 1000, 4096} {
+			var opts ReaderOptions
+			opts.SetChunkSize(chunkSize)
+			r, _, full := newTestReader(t, sizes, opts)
+			require.EqualValues(t, len(full), r.Size())
+			require.NoError(t, iotest.TestReader(r, full), chunkSize)
+		}
+	})
+
+	t.Run("read at", func(t *testing.T) {
+		var opts ReaderOptions
+		opts.SetChunkSize(300)
+		r, _, full := newTestReader(t, sizes, opts)
+
+		for _, tc := range []struct{ off, ln int }{
+			{0, 1000}, {999, 2}, {1000, 1}, {1000, 2501}, {500, 3000}, {0, len(full)}, {len(full) - 1, 1},
+		} {
+			buf := make([]byte, tc.ln)
+			n, err := r.ReadAt(buf, int64(tc.off))
+			require.NoError(t, err, tc)
+			require.Equal(t, tc.ln, n, tc)
+			require.Equal(t, full[tc.off:tc.off+tc.ln], buf, tc)
+		}
+
+		buf := make([]byte, 10)
+		n, err := r.ReadAt(buf, int64(len(full)-5))
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, 5, n)
+		require.Equal(t, full[len(full)-5:], buf[:n])
+
+		_, err = r.ReadAt(buf, int64(len(full)))
+		require.ErrorIs(t, err, io.EOF)
+		_, err = r.ReadAt(buf, -1)
+		require.EqualError(t, err, "negative offset")
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		var opts ReaderOptions
+		opts.SetChunkSize(10)
+		opts.SetConcurrency(3)
+		r, storage, full := newTestReader(t, sizes, opts)
+		storage.delay = time.Millisecond
+
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, full, b)
+		require.LessOrEqual(t, storage.maxAct, 3)
+		require.Greater(t, storage.maxAct, 1)
+	})
+
+	t.Run("no read-ahead", func(t *testing.T) {
+		var opts ReaderOptions
+		opts.SetChunkSize(100)
+		opts.SetReadAhead(0)
+		// chunks do not cross part boundaries
+		r, storage, full := newTestReader(t, []int{1000, 500}, opts)
+		storage.delay = time.Millisecond
+
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, full, b)
+		require.Equal(t, 1, storage.maxAct)
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		var opts ReaderOptions
+		opts.SetChunkSize(100)
+		r, storage, full := newTestReader(t, sizes, opts)
+
+		off, err := r.Seek(-10, io.SeekEnd)
+		require.NoError(t, err)
+		require.EqualValues(t, len(full)-10, off)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, full[len(full)-10:], b)
+		// only the tail chunk is requested
+		require.EqualValues(t, 1, storage.ranges.Load())
+
+		_, err = r.Seek(-1, io.SeekStart)
+		require.EqualError(t, err, "negative position")
+		_, err = r.Seek(0, 3)
+		require.EqualError(t, err, "invalid whence 3")
+	})
+
+	t.Run("http", func(t *testing.T) {
+		r, _, full := newTestReader(t, sizes, ReaderOptions{})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Range", "bytes=900-1100")
+		rec := httptest.NewRecorder()
+		http.ServeContent(rec, req, "object", time.Time{}, r)
+
+		require.Equal(t, http.StatusPartialContent, rec.Code)
+		require.Equal(t, full[900:1101], rec.Body.Bytes())
+	})
+
+	t.Run("range failure", func(t *testing.T) {
+		r, storage, _ := newTestReader(t, sizes, ReaderOptions{})
+		storage.rangeErr = errors.New("any range error")
+
+		_, err := r.ReadAt(make([]byte, 10), 0)
+		require.ErrorIs(t, err, storage.rangeErr)
+		require.True(t, strings.HasPrefix(err.Error(), "read range [0:10] of part #0 ("), err)
+
+		_, err = r.Read(make([]byte, 10))
+		require.ErrorIs(t, err, storage.rangeErr)
+	})
+
+	t.Run("closed", func(t *testing.T) {
+		r, _, _ := newTestReader(t, sizes, ReaderOptions{})
+		require.NoError(t, r.Close())
+
+		_, err := r.ReadAt(make([]byte, 10), 0)
+		require.ErrorIs(t, err, context.Canceled)
+		_, err = r.Read(make([]byte, 10))
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewReader(context.Background(), &partsStorage{}, cidtest.ID(), nil, Tokens{}, usertest.User(), ReaderOptions{})
+		require.EqualError(t, err, "no parts")
+
+		storage := &partsStorage{headErr: errors.New("any head error")}
+		id := oidtest.ID()
+		_, err = NewReader(context.Background(), storage, cidtest.ID(), []oid.ID{id}, Tokens{}, usertest.User(), ReaderOptions{})
+		require.EqualError(t, err, "header of part #0 ("+id.String()+"): any head error")
+	})
+}
+
+func BenchmarkReader_ReadAt(b *testing.B) {
+	storage := &partsStorage{parts: make(map[oid.ID][]byte)}
+	ids := oidtest.IDs(8)
+	for i := range ids {
+		storage.parts[ids[i]] = bytes.Repeat([]byte{byte(i)}, 1<<20)
+	}
+
+	r, err := NewReader(context.Background(), storage, cidtest.ID(), ids, Tokens{}, usertest.User(), ReaderOptions{})
+	require.NoError(b, err)
+	r.getRange = storage.getRange
+	buf := make([]byte, 4<<20)
+
+	for b.Loop() {
+		_, err = r.ReadAt(buf, 1<<19)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
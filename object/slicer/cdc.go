@@ -0,0 +1,96 @@
+package slicer
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// gearTable is a table of random values used by the rolling hash of the
+// content-defined chunking. The values MUST NOT change since they determine
+// boundaries of the child objects.
+var gearTable = func() (res [256]uint64) {
+	// splitmix64 with fixed seed
+	seed := uint64(0x6e656f6673636463) // "neofscdc"
+	for i := range res {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		res[i] = z ^ (z >> 31)
+	}
+	return res
+}()
+
+// contentChunker determines boundaries of the child objects using FastCDC
+// algorithm with normalized chunking.
+type contentChunker struct {
+	minSize, avgSize, maxSize uint64
+	// masks applied to the hash before and after the average size
+	maskS, maskL uint64
+
+	// number of bytes processed in the current chunk
+	pos  uint64
+	hash uint64
+	// chunk size if the boundary is found, 0 otherwise
+	cut uint64
+}
+
+func newContentChunker(minSize, avgSize, maxSize uint64) (*contentChunker, error) {
+	if minSize == 0 || avgSize <= minSize || maxSize <= avgSize {
+		return nil, fmt.Errorf("invalid chunk sizes: min=%d, avg=%d, max=%d", minSize, avgSize, maxSize)
+	}
+
+	avgBits := bits.Len64(avgSize) - 1
+	if avgBits < 3 {
+		return nil, errors.New("average chunk size is too small")
+	}
+
+	return &contentChunker{
+		minSize: minSize,
+		avgSize: avgSize,
+		maxSize: maxSize,
+		// more bits are checked before the average size to make smaller chunks
+		// unlikely and vice versa
+		maskS: ^uint64(0) << (64 - min(avgBits+2, 64)),
+		maskL: ^uint64(0) << (64 - (avgBits - 2)),
+	}, nil
+}
+
+// limit processes the next portion of the current chunk data and returns
+// the chunk size if its boundary is found. Otherwise, limit returns def and
+// the whole portion is processed.
+func (x *contentChunker) limit(data []byte, def uint64) uint64 {
+	if x.cut > 0 {
+		return x.cut
+	}
+
+	for i := range data {
+		x.pos++
+		if x.pos >= x.maxSize {
+			x.cut = x.pos
+			return x.cut
+		}
+		if x.pos <= x.minSize {
+			continue
+		}
+
+		x.hash = (x.hash << 1) + gearTable[data[i]]
+
+		mask := x.maskL
+		if x.pos < x.avgSize {
+			mask = x.maskS
+		}
+		if x.hash&mask == 0 {
+			x.cut = x.pos
+			return x.cut
+		}
+	}
+
+	return def
+}
+
+// reset resets the chunker state to process the next chunk.
+func (x *contentChunker) reset() {
+	x.pos, x.hash, x.cut = 0, 0, 0
+}
@@ -0,0 +1,280 @@
+package slicer
+
+import (
+	"context"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+)
+
+const checkpointVersion = 0
+
+// Checkpoint is a state of the [PayloadWriter] allowing to resume interrupted
+// upload of the split object. Checkpoint reflects stored child objects only,
+// so upload must be resumed from the [Checkpoint.Offset] byte of the payload.
+//
+// Checkpoint is obtained via [PayloadWriter.Checkpoint] and used by
+// [ResumePut].
+type Checkpoint struct {
+	header   object.Object
+	length   uint64
+	checksum []byte
+	children []object.MeasuredObject
+}
+
+// Offset returns number of payload bytes stored in the child objects.
+func (x Checkpoint) Offset() uint64 {
+	return x.length
+}
+
+// Children returns already stored child objects in the split chain order.
+//
+// The value returned shares memory with the structure itself, so changing it
+// can lead to data corruption. Make a copy if you need to change it.
+func (x Checkpoint) Children() []object.MeasuredObject {
+	return x.children
+}
+
+// FirstID returns ID of the first child object. Returns zero ID if there are
+// no stored children.
+func (x Checkpoint) FirstID() oid.ID {
+	if len(x.children) == 0 {
+		return oid.ID{}
+	}
+	return x.children[0].ObjectID()
+}
+
+// PreviousID returns ID of the last stored child object. Returns zero ID if
+// there are no stored children.
+func (x Checkpoint) PreviousID() oid.ID {
+	if len(x.children) == 0 {
+		return oid.ID{}
+	}
+	return x.children[len(x.children)-1].ObjectID()
+}
+
+// Marshal encodes Checkpoint into a binary format.
+//
+// See also [Checkpoint.Unmarshal].
+func (x Checkpoint) Marshal() []byte {
+	hdr := x.header.Marshal()
+
+	b := make([]byte, 0, 1+3*binary.MaxVarintLen64+len(hdr)+len(x.checksum)+len(x.children)*(oid.Size+binary.MaxVarintLen32))
+	b = append(b, checkpointVersion)
+	b = binary.AppendUvarint(b, uint64(len(hdr)))
+	b = append(b, hdr...)
+	b = binary.AppendUvarint(b, x.length)
+	b = binary.AppendUvarint(b, uint64(len(x.checksum)))
+	b = append(b, x.checksum...)
+	b = binary.AppendUvarint(b, uint64(len(x.children)))
+	for i := range x.children {
+		id := x.children[i].ObjectID()
+		b = append(b, id[:]...)
+		b = binary.AppendUvarint(b, uint64(x.children[i].ObjectSize()))
+	}
+
+	return b
+}
+
+// Unmarshal decodes Checkpoint from the binary format produced by
+// [Checkpoint.Marshal].
+func (x *Checkpoint) Unmarshal(b []byte) error {
+	if len(b) == 0 {
+		return io.ErrUnexpectedEOF
+	}
+	if b[0] != checkpointVersion {
+		return fmt.Errorf("unsupported version %d", b[0])
+	}
+	b = b[1:]
+
+	var errEOF = io.ErrUnexpectedEOF
+	nextUint := func() (uint64, error) {
+		v, n := binary.Uvarint(b)
+		if n <= 0 {
+			return 0, errEOF
+		}
+		b = b[n:]
+		return v, nil
+	}
+	nextBytes := func() ([]byte, error) {
+		l, err := nextUint()
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(b)) < l {
+			return nil, errEOF
+		}
+		res := b[:l]
+		b = b[l:]
+		return res, nil
+	}
+
+	hdrBin, err := nextBytes()
+	if err != nil {
+		return fmt.Errorf("read header: %w", err)
+	}
+	var hdr object.Object
+	if err = hdr.Unmarshal(hdrBin); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+
+	length, err := nextUint()
+	if err != nil {
+		return fmt.Errorf("read payload length: %w", err)
+	}
+
+	cs, err := nextBytes()
+	if err != nil {
+		return fmt.Errorf("read checksum state: %w", err)
+	}
+
+	n, err := nextUint()
+	if err != nil {
+		return fmt.Errorf("read number of children: %w", err)
+	}
+	if n == 0 {
+		if length != 0 || len(cs) != 0 {
+			return errors.New("payload length or checksum state is set without children")
+		}
+	} else if err = newDynamicObjectMetadata().checksum.(encoding.BinaryUnmarshaler).UnmarshalBinary(cs); err != nil {
+		return fmt.Errorf("invalid checksum state: %w", err)
+	}
+	if n > uint64(len(b))/oid.Size {
+		return fmt.Errorf("read children: %w", errEOF)
+	}
+
+	var children []object.MeasuredObject
+	if n > 0 {
+		children = make([]object.MeasuredObject, n)
+	}
+	var total uint64
+	for i := range children {
+		if len(b) < oid.Size {
+			return fmt.Errorf("read child #%d: %w", i, errEOF)
+		}
+		children[i].SetObjectID(oid.ID(b[:oid.Size]))
+		b = b[oid.Size:]
+
+		sz, err := nextUint()
+		if err != nil {
+			return fmt.Errorf("read child #%d: %w", i, err)
+		}
+		if sz > uint64(^uint32(0)) {
+			return fmt.Errorf("invalid child #%d: size overflows uint32", i)
+		}
+		children[i].SetObjectSize(uint32(sz))
+		total += sz
+	}
+
+	if len(b) > 0 {
+		return errors.New("trailing data")
+	}
+	if total != length {
+		return fmt.Errorf("payload length %d mismatches total size of children %d", length, total)
+	}
+
+	x.header = hdr
+	x.length = length
+	x.checksum = cs
+	x.children = children
+
+	return nil
+}
+
+// childCheckpoint is a state of the root object metadata after particular
+// child object.
+type childCheckpoint struct {
+	length   uint64
+	checksum []byte
+}
+
+// Checkpoint returns current state of the upload allowing to continue it via
+// [ResumePut] if the PayloadWriter fails. Only data stored in the intermediate
+// child objects is taken into account, buffered data is lost. If children are
+// uploaded concurrently, Checkpoint waits for the pending uploads and takes
+// into account children stored without gaps only.
+//
+// Checkpoint MUST NOT be called concurrently with other methods and after
+// successful Close.
+func (x *PayloadWriter) Checkpoint() Checkpoint {
+	n := len(x.checkpoints)
+	if x.uploads != nil {
+		n = x.uploads.storedPrefix(n)
+	}
+
+	var res Checkpoint
+	x.initHeader.CopyTo(&res.header)
+	if n == 0 {
+		return res
+	}
+
+	res.length = x.checkpoints[n-1].length
+	res.checksum = x.checkpoints[n-1].checksum
+	res.children = make([]object.MeasuredObject, n)
+	copy(res.children, x.writtenChildren)
+
+	return res
+}
+
+// saveCheckpoint remembers the state of the root object metadata after the
+// intermediate child is written.
+func (x *PayloadWriter) saveCheckpoint() error {
+	cs, err := x.rootMeta.checksum.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("save checksum state: %w", err)
+	}
+
+	x.checkpoints = append(x.checkpoints, childCheckpoint{length: x.rootMeta.length, checksum: cs})
+
+	return nil
+}
+
+// ResumePut works similar to [InitPut], but continues the upload interrupted
+// at the given [Checkpoint]. Returned [PayloadWriter] expects the payload
+// starting from the [Checkpoint.Offset] byte. The root object header is taken
+// from the Checkpoint, while other parameters including [Options] must be the
+// same as for the interrupted upload. Note that [Options.SetPayloadSize]
+// specifies full payload size, not the remaining one.
+func ResumePut(ctx context.Context, ow ObjectWriter, signer user.Signer, cp Checkpoint, opts Options) (*PayloadWriter, error) {
+	res, err := initPayloadStream(ctx, ow, cp.header, signer, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(cp.children) == 0 {
+		return res, nil
+	}
+
+	cp.header.CopyTo(&res.headerObject)
+
+	if opts.payloadSizeFixed && cp.length > opts.payloadSize {
+		return nil, fmt.Errorf("checkpoint offset %d exceeds payload size %d", cp.length, opts.payloadSize)
+	}
+
+	if err = res.rootMeta.checksum.(encoding.BinaryUnmarshaler).UnmarshalBinary(cp.checksum); err != nil {
+		return nil, fmt.Errorf("invalid checksum state: %w", err)
+	}
+	res.rootMeta.length = cp.length
+
+	res.withSplit = true
+	first := cp.FirstID()
+	res.firstObject = &first
+	res.writtenChildren = make([]object.MeasuredObject, len(cp.children))
+	copy(res.writtenChildren, cp.children)
+	res.metaWriter = io.MultiWriter(&res.rootMeta, &res.childMeta)
+
+	res.checkpoints = make([]childCheckpoint, len(cp.children))
+	res.checkpoints[len(cp.children)-1] = childCheckpoint{length: cp.length, checksum: cp.checksum}
+	if res.uploads != nil {
+		for i := range cp.children {
+			res.uploads.done[i] = struct{}{}
+		}
+	}
+
+	return res, nil
+}
@@ -27,6 +27,10 @@ type Options struct {
 	payloadSize      uint64
 
 	splitChainModifier func(*object.Object, io.Reader) error
+
+	uploadConcurrency int
+
+	cdcMinSize, cdcAvgSize, cdcMaxSize uint64
 }
 
 // SetObjectPayloadLimit specifies data size limit for produced physically
@@ -93,6 +97,35 @@ func (x *Options) SetSplitChainModifier(splitChainModifier func(*object.Object,
 	x.splitChainModifier = splitChainModifier
 }
 
+// SetUploadConcurrency specifies the max number of child objects of the split
+// chain to be uploaded concurrently. Headers of the child objects are still
+// formed sequentially, and the linking object is written after all children
+// have been stored. Each concurrent upload holds its own copy of the child
+// payload, so up to n*[Options.ObjectPayloadLimit] bytes of memory may be
+// additionally allocated. If n > 1, [ObjectWriter] must be safe for concurrent
+// use. Values less than 2 mean sequential upload, which is the default.
+func (x *Options) SetUploadConcurrency(n int) {
+	x.uploadConcurrency = n
+}
+
+// SetContentDefinedChunking makes the Slicer to cut the payload into child
+// objects at the boundaries determined by the content (FastCDC algorithm with
+// rolling Gear hash) instead of fixed-size parts. Sizes of the child objects
+// are at least minSize bytes (except the last one), at most maxSize bytes and
+// avgSize bytes on average. Therefore, insertion or deletion of some data
+// changes only the neighbouring child objects, and payloads of other ones stay
+// the same. This allows to deduplicate child objects of the similar payloads
+// by payload checksums. Note that the object may be split even if its payload
+// fits into a single object.
+//
+// Sizes must satisfy 0 < minSize < avgSize < maxSize. maxSize is additionally
+// limited by [Options.ObjectPayloadLimit]. avgSize is rounded down to a power
+// of two and must be at least 8. Zero sizes disable content-defined chunking,
+// which is the default.
+func (x *Options) SetContentDefinedChunking(minSize, avgSize, maxSize uint64) {
+	x.cdcMinSize, x.cdcAvgSize, x.cdcMaxSize = minSize, avgSize, maxSize
+}
+
 // ObjectPayloadLimit returns required max object size.
 func (x *Options) ObjectPayloadLimit() uint64 {
 	return x.objectPayloadLimit
@@ -129,3 +162,15 @@ func (x *Options) CopiesNumber() uint32 {
 func (x *Options) PayloadBuffer() []byte {
 	return x.payloadBuffer
 }
+
+// UploadConcurrency returns the max number of concurrently uploaded child
+// objects.
+func (x *Options) UploadConcurrency() int {
+	return x.uploadConcurrency
+}
+
+// ContentDefinedChunking returns sizes of the child objects set using
+// [Options.SetContentDefinedChunking].
+func (x *Options) ContentDefinedChunking() (minSize, avgSize, maxSize uint64) {
+	return x.cdcMinSize, x.cdcAvgSize, x.cdcMaxSize
+}
@@ -0,0 +1,113 @@
+package slicer
+
+import (
+	"context"
+	"sync"
+)
+
+// parallelUploader executes limited number of object uploads concurrently.
+// First failure cancels all other uploads and is returned from all subsequent
+// calls.
+type parallelUploader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// free payload buffers, also limits the number of concurrent uploads
+	buffers chan []byte
+	wg      sync.WaitGroup
+
+	mtx sync.Mutex
+	err error
+	// indices of successfully uploaded objects
+	done map[int]struct{}
+}
+
+func newParallelUploader(ctx context.Context, concurrency int) *parallelUploader {
+	res := &parallelUploader{
+		buffers: make(chan []byte, concurrency),
+		done:    make(map[int]struct{}),
+	}
+	res.ctx, res.cancel = context.WithCancel(ctx)
+	for range concurrency {
+		res.buffers <- nil
+	}
+	return res
+}
+
+// error returns the first upload error.
+func (x *parallelUploader) error() error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	return x.err
+}
+
+func (x *parallelUploader) fail(err error) {
+	x.mtx.Lock()
+	if x.err == nil {
+		x.err = err
+		x.cancel()
+	}
+	x.mtx.Unlock()
+}
+
+// start waits for a free upload slot, copies payload into the slot buffer and
+// calls upload of i-th object in a separate goroutine. Payload buffers may be
+// reused right after return.
+func (x *parallelUploader) start(i int, payloadBuffers [][]byte, upload func(ctx context.Context, payload []byte) error) error {
+	if err := x.error(); err != nil {
+		return err
+	}
+
+	var buf []byte
+	select {
+	case <-x.ctx.Done():
+		if err := x.error(); err != nil {
+			return err
+		}
+		return x.ctx.Err()
+	case buf = <-x.buffers:
+	}
+
+	buf = buf[:0]
+	for i := range payloadBuffers {
+		buf = append(buf, payloadBuffers[i]...)
+	}
+
+	x.wg.Add(1)
+	go func() {
+		defer x.wg.Done()
+		if err := upload(x.ctx, buf); err != nil {
+			x.fail(err)
+		} else {
+			x.mtx.Lock()
+			x.done[i] = struct{}{}
+			x.mtx.Unlock()
+		}
+		x.buffers <- buf
+	}()
+
+	return nil
+}
+
+// storedPrefix waits for all started uploads to finish and returns the number
+// of first n objects uploaded without gaps.
+func (x *parallelUploader) storedPrefix(n int) int {
+	x.wg.Wait()
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	for i := range n {
+		if _, ok := x.done[i]; !ok {
+			return i
+		}
+	}
+	return n
+}
+
+// wait waits for all started uploads to finish and returns the first error.
+// No more uploads can be started after wait.
+func (x *parallelUploader) wait() error {
+	x.wg.Wait()
+	x.cancel()
+	return x.error()
+}
@@ -292,6 +292,24 @@ func initPayloadStream(ctx context.Context, ow ObjectWriter, header object.Objec
 		res.payloadSizeLimit = res.payloadSize
 	}
 
+	if opts.cdcMinSize != 0 || opts.cdcAvgSize != 0 || opts.cdcMaxSize != 0 {
+		res.chunker, err = newContentChunker(opts.cdcMinSize, opts.cdcAvgSize, opts.cdcMaxSize)
+		if err != nil {
+			return nil, fmt.Errorf("content-defined chunking: %w", err)
+		}
+		if res.chunker.maxSize = min(res.chunker.maxSize, res.payloadSizeLimit); res.chunker.maxSize <= res.chunker.minSize {
+			// no boundaries within the limit
+			res.chunker = nil
+		}
+	}
+
+	// root header is finalized on Close, so the initial one is kept for checkpoints
+	header.CopyTo(&res.initHeader)
+
+	if opts.uploadConcurrency > 1 {
+		res.uploads = newParallelUploader(ctx, opts.uploadConcurrency)
+	}
+
 	res.payloadBuffer = opts.payloadBuffer
 	res.rootMeta.reset()
 	res.metaWriter = &res.rootMeta
@@ -306,6 +324,7 @@ type PayloadWriter struct {
 
 	rootID       oid.ID
 	headerObject object.Object
+	initHeader   object.Object
 
 	signer         user.Signer
 	container      cid.ID
@@ -335,6 +354,15 @@ type PayloadWriter struct {
 	stubObject       *object.Object
 
 	splitChainModifier func(*object.Object, io.Reader) error
+
+	// nil if children are uploaded sequentially
+	uploads *parallelUploader
+
+	// nil if payload is cut into fixed-size parts
+	chunker *contentChunker
+
+	// states of the root object metadata after each intermediate child
+	checkpoints []childCheckpoint
 }
 
 var errPayloadSizeExceeded = errors.New("payload size exceeded")
@@ -357,7 +385,12 @@ func (x *PayloadWriter) Write(chunk []byte) (int, error) {
 		buffered = x.childMeta.length
 	}
 
-	if buffered+uint64(len(chunk)) <= x.payloadSizeLimit {
+	limit := x.payloadSizeLimit
+	if x.chunker != nil {
+		limit = x.chunker.limit(chunk, limit)
+	}
+
+	if buffered+uint64(len(chunk)) <= limit {
 		// buffer data to produce as few objects as possible for better storage efficiency
 		_, err := x.metaWriter.Write(chunk)
 		if err != nil {
@@ -397,7 +430,7 @@ func (x *PayloadWriter) Write(chunk []byte) (int, error) {
 	}
 
 	// at this point there is enough data to flush the buffer by sending the next
-	n := int(x.payloadSizeLimit - buffered)
+	n := int(limit - buffered)
 	_, err := x.metaWriter.Write(chunk[:n])
 	if err != nil {
 		return 0, err
@@ -439,6 +472,9 @@ func (x *PayloadWriter) Write(chunk []byte) (int, error) {
 	}
 
 	x.childMeta.reset()
+	if x.chunker != nil {
+		x.chunker.reset()
+	}
 
 	n2, err := x.Write(chunk[n:]) // here n > 0 so infinite recursion shouldn't occur
 
@@ -601,11 +637,18 @@ func (x *PayloadWriter) _writeChild(ctx context.Context, meta dynamicObjectMetad
 	var id oid.ID
 	var err error
 
-	id, err = x.writeInMemObject(ctx, x.signer, x.stream, obj, payloadBuffers, meta, x.prmObjectPutInit)
+	id, err = x.putObject(ctx, obj, payloadBuffers, meta)
 	if err != nil {
 		return fmt.Errorf("write formed object: %w", err)
 	}
 
+	if last && x.uploads != nil {
+		// linking object must be written after all children
+		if err = x.uploads.wait(); err != nil {
+			return fmt.Errorf("write formed object: %w", err)
+		}
+	}
+
 	if x.withSplit && x.firstObject == nil {
 		x.firstObject = &id
 	}
@@ -616,6 +659,12 @@ func (x *PayloadWriter) _writeChild(ctx context.Context, meta dynamicObjectMetad
 
 	x.writtenChildren = append(x.writtenChildren, measuredObject)
 
+	if !last {
+		if err = x.saveCheckpoint(); err != nil {
+			return err
+		}
+	}
+
 	if x.withSplit && last {
 		var linkObj object.Link
 		linkObj.SetObjects(x.writtenChildren)
@@ -680,6 +729,36 @@ func (x *PayloadWriter) flushObjectMetadata(signer neofscrypto.Signer, meta dyna
 	return id, nil
 }
 
+// putObject writes object to the configured ObjectWriter. If parallel upload
+// is enabled, putObject forms the object header and returns while payload is
+// still being uploaded.
+func (x *PayloadWriter) putObject(ctx context.Context, header object.Object, payloadBuffers [][]byte, meta dynamicObjectMetadata) (oid.ID, error) {
+	if x.uploads == nil {
+		return x.writeInMemObject(ctx, x.signer, x.stream, header, payloadBuffers, meta, x.prmObjectPutInit)
+	}
+
+	id := header.GetID()
+	if id.IsZero() || header.Signature() == nil {
+		var err error
+		if id, err = x.flushObjectMetadata(x.signer, meta, &header, payloadBuffers, false); err != nil {
+			return id, err
+		}
+	}
+
+	// header may share memory with the parent one which is still being formed
+	var hdr object.Object
+	header.CopyTo(&hdr)
+
+	return id, x.uploads.start(len(x.writtenChildren), payloadBuffers, func(ctx context.Context, payload []byte) error {
+		var payloadBuffers [][]byte
+		if len(payload) > 0 {
+			payloadBuffers = [][]byte{payload}
+		}
+		_, err := x.writeInMemObject(ctx, x.signer, x.stream, hdr, payloadBuffers, dynamicObjectMetadata{}, x.prmObjectPutInit)
+		return err
+	})
+}
+
 func (x *PayloadWriter) writeInMemObject(ctx context.Context, signer user.Signer, w ObjectWriter, header object.Object, payloadBuffers [][]byte, meta dynamicObjectMetadata, prm client.PrmObjectPutInit) (oid.ID, error) {
 	var (
 		id  oid.ID
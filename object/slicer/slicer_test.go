@@ -8,13 +8,17 @@ import (
 	cryptorand "crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"hash"
 	"io"
 	"math/rand"
+	"slices"
+	"sync"
 	"testing"
 	"testing/iotest"
+	"time"
 
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
@@ -1334,3 +1338,486 @@ func BenchmarkKnownPayloadSize(b *testing.B) {
 		})
 	}
 }
+
+// concurrentChecker is a slicedObjectChecker safe for concurrent use. It also
+// checks that linking object is written after all children are stored.
+type concurrentChecker struct {
+	*slicedObjectChecker
+
+	mtx     sync.Mutex
+	pending int
+	failOn  int
+	puts    int
+	objects []concurrentObject
+	// objects which may be left out of the final split chain, see resumed.
+	mayOrphan map[oid.ID]struct{}
+}
+
+type concurrentObject struct {
+	hdr     object.Object
+	payload *bytes.Buffer
+}
+
+func (x *concurrentChecker) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.puts++; x.puts == x.failOn {
+		return nil, errors.New("any put error")
+	}
+
+	if hdr.Type() == object.TypeLink {
+		require.Zero(x.tb, x.pending, "linking object must be written after all children")
+	}
+
+	checkStaticMetadata(x.tb, hdr, x.input)
+
+	buf := bytes.NewBuffer(nil)
+	if !slices.ContainsFunc(x.objects, func(o concurrentObject) bool { return o.hdr.GetID() == hdr.GetID() }) {
+		// object may be stored again after resumed upload
+		var hdrCp object.Object
+		hdr.CopyTo(&hdrCp)
+		x.objects = append(x.objects, concurrentObject{hdr: hdrCp, payload: buf})
+	}
+	x.pending++
+
+	return &concurrentPayload{
+		ObjectWriter: newSizeChecker(x.tb, hdr, buf, x.input.payloadLimit),
+		done: func() {
+			x.mtx.Lock()
+			x.pending--
+			x.mtx.Unlock()
+		},
+	}, nil
+}
+
+// resumed marks objects stored so far, except the ones saved in the given
+// checkpoint, as possible orphans: they are uploaded again on resume, and the
+// last child may differ because of the parent signature.
+func (x *concurrentChecker) resumed(cp slicer.Checkpoint) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.mayOrphan = make(map[oid.ID]struct{}, len(x.objects))
+	for i := range x.objects {
+		x.mayOrphan[x.objects[i].hdr.GetID()] = struct{}{}
+	}
+	for _, c := range cp.Children() {
+		delete(x.mayOrphan, c.ObjectID())
+	}
+}
+
+// verify passes collected objects to the chainCollector in the split chain
+// order and verifies the result. All stored objects must be in the chain except
+// the ones replaced after resume, see resumed.
+func (x *concurrentChecker) verify(rootID oid.ID) {
+	var (
+		link    *concurrentObject
+		ordered []concurrentObject
+		byPrev  = make(map[oid.ID]concurrentObject)
+	)
+	for i := range x.objects {
+		switch prev := x.objects[i].hdr.GetPreviousID(); {
+		case x.objects[i].hdr.Type() == object.TypeLink:
+			link = &x.objects[i]
+		case prev.IsZero():
+			ordered = append(ordered, x.objects[i])
+		default:
+			// orphans precede their replacements, so the latter win
+			byPrev[prev] = x.objects[i]
+		}
+	}
+	require.Len(x.tb, ordered, 1)
+	for {
+		next, ok := byPrev[ordered[len(ordered)-1].hdr.GetID()]
+		if !ok {
+			break
+		}
+		ordered = append(ordered, next)
+	}
+	if link != nil {
+		ordered = append(ordered, *link)
+	}
+
+	inChain := make(map[oid.ID]struct{}, len(ordered))
+	for i := range ordered {
+		inChain[ordered[i].hdr.GetID()] = struct{}{}
+	}
+	var orphans, expOrphans []oid.ID
+	for i := range x.objects {
+		if id := x.objects[i].hdr.GetID(); !mapContains(inChain, id) {
+			orphans = append(orphans, id)
+		}
+	}
+	for id := range x.mayOrphan {
+		if !mapContains(inChain, id) {
+			expOrphans = append(expOrphans, id)
+		}
+	}
+	require.ElementsMatch(x.tb, expOrphans, orphans)
+
+	for i := range ordered {
+		x.chainCollector.handleOutgoingObject(ordered[i].hdr, ordered[i].payload)
+	}
+	x.chainCollector.verify(x.input, rootID)
+}
+
+func mapContains[K comparable, V any](m map[K]V, k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+type concurrentPayload struct {
+	client.ObjectWriter
+	done func()
+}
+
+func (x *concurrentPayload) Close() error {
+	defer x.done()
+	// let other uploads overtake
+	time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+	return x.ObjectWriter.(io.Closer).Close()
+}
+
+func headerFromInput(in input) object.Object {
+	var hdr object.Object
+	hdr.SetContainerID(in.container)
+	hdr.SetType(in.objectType)
+	hdr.SetOwner(in.owner)
+	hdr.SetCreationEpoch(in.currentEpoch)
+	hdr.SetAttributes(in.attributes...)
+	if in.sessionTokenV2 != nil {
+		hdr.SetSessionTokenV2(in.sessionTokenV2)
+	}
+	return hdr
+}
+
+func TestOptions_SetUploadConcurrency(t *testing.T) {
+	const limit = 1 << 10
+
+	for _, tc := range []struct {
+		name string
+		ln   uint64
+	}{
+		{name: "no payload", ln: 0},
+		{name: "exactly limit", ln: limit},
+		{name: "limitX10", ln: limit * 10},
+		{name: "limitX10+1B", ln: limit*10 + 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, known := range []bool{false, true} {
+				in, opts := randomInput(tc.ln, limit)
+				in.objectType = object.TypeRegular
+				opts.SetUploadConcurrency(4)
+				if known {
+					opts.SetPayloadSize(tc.ln)
+				}
+				require.Equal(t, 4, opts.UploadConcurrency())
+
+				checker := &concurrentChecker{slicedObjectChecker: &slicedObjectChecker{
+					opts:           opts,
+					tb:             t,
+					input:          in,
+					chainCollector: newChainCollector(t),
+				}}
+
+				rootID, err := slicer.Put(context.Background(), checker, headerFromInput(in), in.signer, bytes.NewReader(in.payload), opts)
+				require.NoError(t, err)
+				checker.verify(rootID)
+			}
+		})
+	}
+
+	t.Run("failure", func(t *testing.T) {
+		for _, failOn := range []int{1, 2, 5, 11} {
+			in, opts := randomInput(limit*10, limit)
+			opts.SetUploadConcurrency(4)
+
+			checker := &concurrentChecker{failOn: failOn, slicedObjectChecker: &slicedObjectChecker{
+				opts:           opts,
+				tb:             t,
+				input:          in,
+				chainCollector: newChainCollector(t),
+			}}
+
+			var hdr object.Object
+			hdr.SetContainerID(in.container)
+			hdr.SetOwner(in.owner)
+
+			_, err := slicer.Put(context.Background(), checker, hdr, in.signer, bytes.NewReader(in.payload), opts)
+			require.ErrorContains(t, err, "any put error", failOn)
+		}
+	})
+}
+
+// randomCheckpointInput is randomInput without the empty attributes, they
+// make header invalid and therefore fail [slicer.Checkpoint.Unmarshal].
+func randomCheckpointInput(size, sizeLimit uint64) (input, slicer.Options) {
+	in, opts := randomInput(size, sizeLimit)
+	in.attributes = slices.DeleteFunc(in.attributes, func(a object.Attribute) bool { return a.Key() == "" })
+	return in, opts
+}
+
+func TestResumePut(t *testing.T) {
+	const limit = 1 << 10
+	ctx := context.Background()
+
+	for _, concurrency := range []int{0, 4} {
+		for _, failOn := range []int{1, 2, 5, 11} {
+			t.Run(fmt.Sprintf("concurrency=%d,fail on=%d", concurrency, failOn), func(t *testing.T) {
+				in, opts := randomCheckpointInput(limit*10+5, limit)
+				// header is restored from the binary checkpoint, so nil and
+				// empty attributes are indistinguishable
+				in.attributes = append(in.attributes, object.NewAttribute("any_key", "any_value"))
+				opts.SetUploadConcurrency(concurrency)
+				opts.SetPayloadSize(uint64(len(in.payload)))
+
+				checker := &concurrentChecker{failOn: failOn, slicedObjectChecker: &slicedObjectChecker{
+					opts:           opts,
+					tb:             t,
+					input:          in,
+					chainCollector: newChainCollector(t),
+				}}
+
+				w, err := slicer.InitPut(ctx, checker, headerFromInput(in), in.signer, opts)
+				require.NoError(t, err)
+				_, err = w.Write(in.payload)
+				if err == nil {
+					err = w.Close()
+				}
+				require.ErrorContains(t, err, "any put error")
+
+				cp := w.Checkpoint()
+				children := cp.Children()
+				require.EqualValues(t, len(children)*limit, cp.Offset())
+				if concurrency == 0 {
+					require.Len(t, children, max(0, min(failOn-1, 10)))
+				}
+				if len(children) > 0 {
+					require.Equal(t, children[0].ObjectID(), cp.FirstID())
+					require.Equal(t, children[len(children)-1].ObjectID(), cp.PreviousID())
+				} else {
+					require.Zero(t, cp.FirstID())
+					require.Zero(t, cp.PreviousID())
+				}
+
+				var restored slicer.Checkpoint
+				require.NoError(t, restored.Unmarshal(cp.Marshal()))
+				require.Equal(t, cp.Offset(), restored.Offset())
+				require.Equal(t, children, restored.Children())
+
+				checker.resumed(restored)
+				w, err = slicer.ResumePut(ctx, checker, in.signer, restored, opts)
+				require.NoError(t, err)
+				_, err = w.ReadFrom(bytes.NewReader(in.payload[restored.Offset():]))
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+
+				checker.verify(w.ID())
+			})
+		}
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		in, opts := randomCheckpointInput(limit*3, limit)
+		checker := &concurrentChecker{failOn: 2, slicedObjectChecker: &slicedObjectChecker{
+			opts:           opts,
+			tb:             t,
+			input:          in,
+			chainCollector: newChainCollector(t),
+		}}
+
+		w, err := slicer.InitPut(ctx, checker, headerFromInput(in), in.signer, opts)
+		require.NoError(t, err)
+		_, err = w.Write(in.payload)
+		require.ErrorContains(t, err, "any put error")
+
+		opts.SetPayloadSize(limit - 1)
+		_, err = slicer.ResumePut(ctx, checker, in.signer, w.Checkpoint(), opts)
+		require.EqualError(t, err, fmt.Sprintf("checkpoint offset %d exceeds payload size %d", limit, limit-1))
+	})
+}
+
+func TestCheckpoint_Unmarshal(t *testing.T) {
+	const limit = 1 << 10
+
+	in, opts := randomCheckpointInput(limit*3, limit)
+	checker := &concurrentChecker{failOn: 2, slicedObjectChecker: &slicedObjectChecker{
+		opts:           opts,
+		tb:             t,
+		input:          in,
+		chainCollector: newChainCollector(t),
+	}}
+
+	w, err := slicer.InitPut(context.Background(), checker, headerFromInput(in), in.signer, opts)
+	require.NoError(t, err)
+	_, err = w.Write(in.payload)
+	require.ErrorContains(t, err, "any put error")
+	b := w.Checkpoint().Marshal()
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name, err string
+			b         []byte
+		}{
+			{name: "empty", err: "unexpected EOF", b: []byte{}},
+			{name: "unsupported version", err: "unsupported version 1", b: append([]byte{1}, b[1:]...)},
+			{name: "no header", err: "read header: unexpected EOF", b: []byte{0}},
+			{name: "truncated header", err: "read header: unexpected EOF", b: []byte{0, 10, 1}},
+			{name: "invalid header", err: "invalid header: invalid header: invalid attribute #0: missing key", b: func() []byte {
+				var hdr object.Object
+				hdr.SetAttributes(object.Attribute{})
+				b := hdr.Marshal()
+				return append(binary.AppendUvarint([]byte{0}, uint64(len(b))), b...)
+			}()},
+			{name: "truncated", err: "read child #0: unexpected EOF", b: b[:len(b)-1]},
+			{name: "trailing data", err: "trailing data", b: append(slices.Clone(b), 0)},
+			{name: "length mismatch", err: fmt.Sprintf("payload length %d mismatches total size of children %d", limit, limit+1),
+				b: append(b[:len(b)-2:len(b)-2], binary.AppendUvarint(nil, limit+1)...)},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				var cp slicer.Checkpoint
+				require.EqualError(t, cp.Unmarshal(tc.b), tc.err)
+			})
+		}
+	})
+}
+
+// childPayloadCollector collects payloads of the regular child objects.
+type childPayloadCollector struct {
+	payloads [][]byte
+}
+
+func (x *childPayloadCollector) ObjectPutInit(_ context.Context, hdr object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	if hdr.Type() == object.TypeLink {
+		return &memoryPayload{}, nil
+	}
+	x.payloads = append(x.payloads, nil)
+	return &childPayloadWriter{b: &x.payloads[len(x.payloads)-1]}, nil
+}
+
+type childPayloadWriter struct {
+	memoryPayload
+	b *[]byte
+}
+
+func (x *childPayloadWriter) Write(p []byte) (int, error) {
+	*x.b = append(*x.b, p...)
+	return len(p), nil
+}
+
+func TestOptions_SetContentDefinedChunking(t *testing.T) {
+	const (
+		limit   = 16 << 10
+		minSize = 1 << 10
+		avgSize = 4 << 10
+		maxSize = 8 << 10
+	)
+
+	put := func(t *testing.T, payload []byte, writeSize int, opts slicer.Options) [][]byte {
+		in, _ := randomInput(0, limit)
+		var w childPayloadCollector
+
+		pw, err := slicer.InitPut(context.Background(), &w, headerFromInput(in), in.signer, opts)
+		require.NoError(t, err)
+		for b := payload; len(b) > 0; {
+			n := min(writeSize, len(b))
+			_, err = pw.Write(b[:n])
+			require.NoError(t, err)
+			b = b[n:]
+		}
+		require.NoError(t, pw.Close())
+
+		require.Equal(t, payload, bytes.Join(w.payloads, nil))
+		return w.payloads
+	}
+
+	var opts slicer.Options
+	opts.SetObjectPayloadLimit(limit)
+	opts.SetContentDefinedChunking(minSize, avgSize, maxSize)
+	mn, avg, mx := opts.ContentDefinedChunking()
+	require.EqualValues(t, minSize, mn)
+	require.EqualValues(t, avgSize, avg)
+	require.EqualValues(t, maxSize, mx)
+
+	payload := testutil.RandByteSlice(256 << 10)
+
+	t.Run("sizes", func(t *testing.T) {
+		children := put(t, payload, len(payload), opts)
+		require.Greater(t, len(children), len(payload)/maxSize)
+		for i := range children[:len(children)-1] {
+			require.GreaterOrEqual(t, len(children[i]), minSize)
+			require.LessOrEqual(t, len(children[i]), maxSize)
+		}
+		require.LessOrEqual(t, len(children[len(children)-1]), maxSize)
+	})
+
+	t.Run("chain", func(t *testing.T) {
+		in, opts := randomInput(limit*3+1, limit)
+		opts.SetContentDefinedChunking(minSize, avgSize, maxSize)
+		in.objectType = object.TypeRegular
+
+		testSlicerByHeaderType(t, &slicedObjectChecker{
+			opts:           opts,
+			tb:             t,
+			input:          in,
+			chainCollector: newChainCollector(t),
+		}, in, opts)
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+		exp := put(t, payload, len(payload), opts)
+		for _, writeSize := range []int{1, 100, minSize + 1, limit * 2} {
+			require.Equal(t, exp, put(t, payload, writeSize, opts), writeSize)
+		}
+
+		var r slicer.Options
+		r.SetObjectPayloadLimit(limit)
+		r.SetContentDefinedChunking(minSize, avgSize, maxSize)
+		r.SetPayloadSize(uint64(len(payload)))
+		r.SetPayloadBuffer(make([]byte, 3*minSize))
+		require.Equal(t, exp, put(t, payload, 777, r))
+	})
+
+	t.Run("insertion", func(t *testing.T) {
+		edited := slices.Concat(payload[:len(payload)/2], []byte{1, 2, 3}, payload[len(payload)/2:])
+
+		orig := put(t, payload, len(payload), opts)
+		changed := put(t, edited, len(edited), opts)
+
+		var same int
+		for i := range changed {
+			if slices.ContainsFunc(orig, func(b []byte) bool { return bytes.Equal(b, changed[i]) }) {
+				same++
+			}
+		}
+		// only chunks around the insertion may change
+		require.GreaterOrEqual(t, same, len(changed)-3)
+	})
+
+	t.Run("small payload", func(t *testing.T) {
+		var o slicer.Options
+		o.SetObjectPayloadLimit(limit)
+		o.SetContentDefinedChunking(minSize, avgSize, maxSize)
+		o.SetPayloadSize(minSize)
+		require.Len(t, put(t, payload[:minSize], minSize, o), 1)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		in, _ := randomInput(0, limit)
+		for _, tc := range []struct {
+			min, avg, max uint64
+			err           string
+		}{
+			{min: 0, avg: 2, max: 3, err: "content-defined chunking: invalid chunk sizes: min=0, avg=2, max=3"},
+			{min: 2, avg: 2, max: 3, err: "content-defined chunking: invalid chunk sizes: min=2, avg=2, max=3"},
+			{min: 1, avg: 3, max: 3, err: "content-defined chunking: invalid chunk sizes: min=1, avg=3, max=3"},
+			{min: 1, avg: 7, max: 10, err: "content-defined chunking: average chunk size is too small"},
+		} {
+			var o slicer.Options
+			o.SetContentDefinedChunking(tc.min, tc.avg, tc.max)
+			_, err := slicer.InitPut(context.Background(), discardObject{}, headerFromInput(in), in.signer, o)
+			require.EqualError(t, err, tc.err)
+		}
+	})
+}
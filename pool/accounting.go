@@ -5,16 +5,28 @@ import (
 
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
 )
 
 // BalanceGet requests current balance of the NeoFS account.
 //
 // See details in [client.Client.BalanceGet].
 func (p *Pool) BalanceGet(ctx context.Context, prm client.PrmBalanceGet) (accounting.Decimal, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodBalanceGet)
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return accounting.Decimal{}, err
 	}
 
-	return c.BalanceGet(ctx, prm)
+	var res accounting.Decimal
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.BalanceGet(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
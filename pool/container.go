@@ -8,6 +8,8 @@ import (
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
@@ -15,92 +17,148 @@ import (
 //
 // See details in [client.Client.ContainerPut].
 func (p *Pool) ContainerPut(ctx context.Context, cont container.Container, signer neofscrypto.Signer, prm client.PrmContainerPut) (cid.ID, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerPut)
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return cid.ID{}, err
 	}
 
-	return c.ContainerPut(ctx, cont, signer, prm)
+	var id cid.ID
+	id, err = c.ContainerPut(ctx, cont, signer, prm)
+	return id, err
 }
 
 // ContainerGet reads NeoFS container by ID.
 //
 // See details in [client.Client.ContainerGet].
 func (p *Pool) ContainerGet(ctx context.Context, id cid.ID, prm client.PrmContainerGet) (container.Container, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerGet, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return container.Container{}, err
 	}
 
-	return c.ContainerGet(ctx, id, prm)
+	var res container.Container
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.ContainerGet(ctx, id, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // ContainerList requests identifiers of the account-owned containers.
 //
 // See details in [client.Client.ContainerList].
 func (p *Pool) ContainerList(ctx context.Context, ownerID user.ID, prm client.PrmContainerList) ([]cid.ID, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerList)
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return []cid.ID{}, err
 	}
 
-	return c.ContainerList(ctx, ownerID, prm)
+	var res []cid.ID
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.ContainerList(ctx, ownerID, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // ContainerDelete sends request to remove the NeoFS container.
 //
 // See details in [client.Client.ContainerDelete].
 func (p *Pool) ContainerDelete(ctx context.Context, id cid.ID, signer neofscrypto.Signer, prm client.PrmContainerDelete) error {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerDelete, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return err
 	}
 
-	return c.ContainerDelete(ctx, id, signer, prm)
+	err = c.ContainerDelete(ctx, id, signer, prm)
+	return err
 }
 
 // ContainerEACL reads eACL table of the NeoFS container.
 //
 // See details in [client.Client.ContainerEACL].
 func (p *Pool) ContainerEACL(ctx context.Context, id cid.ID, prm client.PrmContainerEACL) (eacl.Table, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerEACL, telemetry.ContainerID(id))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return eacl.Table{}, err
 	}
 
-	return c.ContainerEACL(ctx, id, prm)
+	var res eacl.Table
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.ContainerEACL(ctx, id, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // ContainerSetEACL sends request to update eACL table of the NeoFS container.
 //
 // See details in [client.Client.ContainerSetEACL].
 func (p *Pool) ContainerSetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm client.PrmContainerSetEACL) error {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerSetEACL, telemetry.ContainerID(table.GetCID()))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return err
 	}
 
-	return c.ContainerSetEACL(ctx, table, signer, prm)
+	err = c.ContainerSetEACL(ctx, table, signer, prm)
+	return err
 }
 
 // SetContainerAttribute selects a suitable connection from the pool and calls
 // [client.Client.SetContainerAttribute] on it.
 func (p *Pool) SetContainerAttribute(ctx context.Context, prm client.SetContainerAttributeParameters, prmSig neofscrypto.Signature, opts client.SetContainerAttributeOptions) error {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerSetAttribute, telemetry.ContainerID(prm.ID))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return err
 	}
 
-	return c.SetContainerAttribute(ctx, prm, prmSig, opts)
+	err = c.SetContainerAttribute(ctx, prm, prmSig, opts)
+	return err
 }
 
 // RemoveContainerAttribute selects a suitable connection from the pool and calls
 // [client.Client.RemoveContainerAttribute] on it.
 func (p *Pool) RemoveContainerAttribute(ctx context.Context, prm client.RemoveContainerAttributeParameters, prmSig neofscrypto.Signature, opts client.RemoveContainerAttributeOptions) error {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodContainerRemoveAttribute, telemetry.ContainerID(prm.ID))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return err
 	}
 
-	return c.RemoveContainerAttribute(ctx, prm, prmSig, opts)
+	err = c.RemoveContainerAttribute(ctx, prm, prmSig, opts)
+	return err
 }
@@ -10,5 +10,19 @@ The mechanism allows to manipulate objects like upload, download, delete, etc, w
 This behavior may be disabled per request by calling IgnoreSession() on the appropriate Prm* argument.
 Note that if auto-session is disabled, the user MUST provide the appropriate session manually for PUT and DELETE object operations.
 The user may provide session, for another object operations.
+
+Optionally, Pool can route object read requests to the nodes storing the
+requested object according to the container placement policy and the current
+network map. See InitParameters.EnablePlacementRouting for details.
+
+To cut tail latency of object reads, the Pool can duplicate slow HEAD and GET
+requests to another node and use the first response. See
+InitParameters.SetHedgingDelay for details.
+
+To protect the nodes from request bursts, the Pool can limit the number of
+concurrent requests to all nodes and to each of them, as well as the rate of
+object and container operations. See InitParameters.SetMaxInFlight,
+InitParameters.SetMaxInFlightPerNode and InitParameters.SetRateLimit for
+details.
 */
 package pool
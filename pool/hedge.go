@@ -0,0 +1,114 @@
+package pool
+
+import (
+	"context"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"go.uber.org/zap"
+)
+
+type hedgedResult[T any] struct {
+	i   int
+	res T
+	err error
+}
+
+// hedgingDelay returns delay after which the read request of the given method
+// is duplicated to another node. Non-positive result means no hedging.
+func (p *Pool) hedgingDelay(method stat.Method) time.Duration {
+	if p.hedgingDelayFunc == nil {
+		return 0
+	}
+	return p.hedgingDelayFunc(method)
+}
+
+// hedgedRead executes op on c and, if it does not finish within the hedging
+// delay, concurrently executes it on the connection returned by next. Result
+// of the first succeeded execution is returned, the other one is cancelled. If
+// both executions fail, the last error is returned. If op fails before the
+// delay, its error is returned immediately.
+//
+// Context passed to the winning op is not cancelled on return, so it can be
+// used after it, e.g. to read payload stream. Returned function cancels it and
+// must be called once the result is no longer needed. Result of the cancelled
+// op is passed to discard if it succeeded anyway, discard may be nil.
+func hedgedRead[T any](ctx context.Context, p *Pool, c *sdkClientWrapper, method stat.Method,
+	next func(exclude ...string) (*sdkClientWrapper, error), op func(context.Context, *sdkClientWrapper) (T, error),
+	discard func(T)) (T, context.CancelFunc, error) {
+	delay := p.hedgingDelay(method)
+	if delay <= 0 {
+		res, err := op(ctx, c)
+		return res, func() {}, err
+	}
+
+	var (
+		cancels [2]context.CancelFunc
+		running int
+		results = make(chan hedgedResult[T], len(cancels))
+	)
+	run := func(i int, c *sdkClientWrapper) {
+		var opCtx context.Context
+		opCtx, cancels[i] = context.WithCancel(ctx)
+		running++
+		go func() {
+			res, err := op(opCtx, c)
+			results <- hedgedResult[T]{i: i, res: res, err: err}
+		}()
+	}
+
+	run(0, c)
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	var last hedgedResult[T]
+	for {
+		select {
+		case <-t.C:
+			hc, err := next(c.addr)
+			if err != nil || hc.addr == c.addr {
+				if p.logger != nil {
+					p.logger.Debug("no connection for hedged request",
+						zap.Stringer("method", method), zap.String("primary", c.addr), zap.Error(err))
+				}
+				continue
+			}
+
+			if p.logger != nil {
+				p.logger.Debug("sending hedged request",
+					zap.Stringer("method", method), zap.String("primary", c.addr), zap.String("hedged", hc.addr))
+			}
+
+			run(1, hc)
+		case last = <-results:
+			running--
+			if last.err == nil {
+				for i := range cancels {
+					if i != last.i && cancels[i] != nil {
+						cancels[i]()
+					}
+				}
+				if running > 0 {
+					go drainHedgedResults(results, running, discard)
+				}
+				return last.res, cancels[last.i], nil
+			}
+
+			cancels[last.i]()
+			if running == 0 {
+				return last.res, func() {}, last.err
+			}
+		}
+	}
+}
+
+// drainHedgedResults reads n results of the cancelled ops passing succeeded
+// ones to discard.
+func drainHedgedResults[T any](results <-chan hedgedResult[T], n int, discard func(T)) {
+	for range n {
+		if r := <-results; r.err == nil && discard != nil {
+			discard(r.res)
+		}
+	}
+}
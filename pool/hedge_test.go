@@ -0,0 +1,194 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_Hedging(t *testing.T) {
+	usr := usertest.User()
+
+	newPool := func(t *testing.T, opts InitParameters) (*Pool, map[string]*mockClient) {
+		clients := make(map[string]*mockClient)
+		opts.setClientBuilder(func(addr string) (internalClient, error) {
+			c := newMockClient(addr, neofscryptotest.Signer())
+			clients[addr] = c
+			return c, nil
+		})
+		opts.signer = usr.RFC6979
+		opts.AddNode(NewNodeParam(1, anyValidPeerAddress(0), 1))
+		opts.AddNode(NewNodeParam(1, anyValidPeerAddress(1), 1))
+
+		p, err := NewPool(opts)
+		require.NoError(t, err)
+		require.NoError(t, p.Dial(t.Context()))
+		t.Cleanup(func() { _ = p.Close })
+		return p, clients
+	}
+
+	head := func(t *testing.T, p *Pool) (string, error) {
+		hdr, err := p.ObjectHead(t.Context(), cidtest.ID(), oidtest.ID(), usr, client.PrmObjectHead{})
+		if err != nil {
+			return "", err
+		}
+		return hdr.Attributes()[0].Value(), nil
+	}
+
+	t.Run("slow node", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(10 * time.Millisecond)
+		p, clients := newPool(t, opts)
+		slow := clients[anyValidPeerAddress(0)]
+		slow.headDelay = time.Minute
+
+		for range 10 {
+			addr, err := head(t, p)
+			require.NoError(t, err)
+			require.Equal(t, anyValidPeerAddress(1), addr)
+		}
+		require.Eventually(t, func() bool {
+			return slow.headsCancelled.Load() > 0
+		}, time.Second, time.Millisecond)
+		// cancelled hedged requests must not affect node health
+		require.True(t, slow.isHealthy())
+	})
+
+	t.Run("cancelled loser", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(time.Millisecond)
+		p, clients := newPool(t, opts)
+		slow := clients[anyValidPeerAddress(0)]
+		slow.headDelay = time.Minute
+		slow.setThreshold(1)
+
+		for range 10 {
+			_, err := head(t, p)
+			require.NoError(t, err)
+		}
+		require.Eventually(t, func() bool {
+			return slow.headsCancelled.Load() > 1
+		}, time.Second, time.Millisecond)
+		require.True(t, slow.isHealthy())
+	})
+
+	t.Run("fast nodes", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(time.Minute)
+		p, _ := newPool(t, opts)
+
+		for range 10 {
+			_, err := head(t, p)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("failure before delay", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(time.Minute)
+		p, clients := newPool(t, opts)
+		for _, c := range clients {
+			c.errOnHeadObject = apistatus.ErrObjectNotFound
+		}
+
+		_, err := head(t, p)
+		require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+	})
+
+	t.Run("all failed", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(time.Millisecond)
+		p, clients := newPool(t, opts)
+		for _, c := range clients {
+			c.headDelay = 20 * time.Millisecond
+			c.errOnHeadObject = apistatus.ErrObjectNotFound
+		}
+
+		_, err := head(t, p)
+		require.ErrorIs(t, err, apistatus.ErrObjectNotFound)
+		for _, c := range clients {
+			require.Zero(t, c.headsCancelled.Load())
+		}
+	})
+
+	t.Run("delay func", func(t *testing.T) {
+		var (
+			mtx     sync.Mutex
+			methods []stat.Method
+		)
+		var opts InitParameters
+		opts.SetHedgingDelayFunc(func(m stat.Method) time.Duration {
+			mtx.Lock()
+			methods = append(methods, m)
+			mtx.Unlock()
+			return 0
+		})
+		p, clients := newPool(t, opts)
+		for _, c := range clients {
+			c.headDelay = 10 * time.Millisecond
+		}
+
+		_, err := head(t, p)
+		require.NoError(t, err)
+		_, _, err = p.ObjectGetInit(t.Context(), cidtest.ID(), oidtest.ID(), usr, client.PrmObjectGet{})
+		require.NoError(t, err)
+
+		require.Equal(t, []stat.Method{stat.MethodObjectHead, stat.MethodObjectGet}, methods)
+		for _, c := range clients {
+			require.Zero(t, c.headsCancelled.Load())
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetHedgingDelay(time.Second)
+		opts.SetHedgingDelay(0)
+		p, _ := newPool(t, opts)
+		require.Nil(t, p.hedgingDelayFunc)
+	})
+}
+
+func TestHedgedRead(t *testing.T) {
+	type result struct {
+		addr   string
+		ctx    context.Context
+		closed atomic.Bool
+	}
+	p := &Pool{hedgingDelayFunc: func(stat.Method) time.Duration { return time.Millisecond }}
+	next := func(...string) (*sdkClientWrapper, error) { return &sdkClientWrapper{addr: "hedged"}, nil }
+	op := func(ctx context.Context, c *sdkClientWrapper) (*result, error) {
+		if c.addr == "primary" {
+			time.Sleep(50 * time.Millisecond) // and succeeds anyway
+		}
+		return &result{addr: c.addr, ctx: ctx}, nil
+	}
+
+	discarded := make(chan *result, 1)
+	res, cancel, err := hedgedRead(t.Context(), p, &sdkClientWrapper{addr: "primary"}, stat.MethodObjectGet, next, op,
+		func(r *result) { discarded <- r })
+	require.NoError(t, err)
+	require.Equal(t, "hedged", res.addr)
+	require.NoError(t, res.ctx.Err())
+
+	select {
+	case r := <-discarded:
+		require.Equal(t, "primary", r.addr)
+		require.ErrorIs(t, r.ctx.Err(), context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("loser result is not discarded")
+	}
+
+	cancel()
+	require.ErrorIs(t, res.ctx.Err(), context.Canceled)
+}
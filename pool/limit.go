@@ -0,0 +1,387 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"golang.org/x/time/rate"
+)
+
+// OperationClass groups [Pool] operations sharing the same rate limit, see
+// [InitParameters.SetRateLimit].
+type OperationClass uint8
+
+const (
+	// OperationClassObjectWrite includes object PUT and DELETE operations.
+	OperationClassObjectWrite OperationClass = iota
+	// OperationClassObjectRead includes object GET, HEAD, RANGE, HASH and
+	// SEARCH operations.
+	OperationClassObjectRead
+	// OperationClassContainer includes all container operations.
+	OperationClassContainer
+
+	operationClassLast
+)
+
+// String implements [fmt.Stringer].
+func (x OperationClass) String() string {
+	switch x {
+	case OperationClassObjectWrite:
+		return "object write"
+	case OperationClassObjectRead:
+		return "object read"
+	case OperationClassContainer:
+		return "container"
+	default:
+		return fmt.Sprintf("unknown class %d", x)
+	}
+}
+
+// methodClass returns class of the operation rate limited by the Pool.
+func methodClass(m stat.Method) (OperationClass, bool) {
+	switch m {
+	case stat.MethodObjectPut, stat.MethodObjectDelete:
+		return OperationClassObjectWrite, true
+	case stat.MethodObjectGet, stat.MethodObjectHead, stat.MethodObjectRange, stat.MethodObjectHash,
+		stat.MethodObjectSearch, stat.MethodObjectSearchV2:
+		return OperationClassObjectRead, true
+	case stat.MethodContainerPut, stat.MethodContainerGet, stat.MethodContainerList, stat.MethodContainerDelete,
+		stat.MethodContainerEACL, stat.MethodContainerSetEACL, stat.MethodContainerSetAttribute,
+		stat.MethodContainerRemoveAttribute:
+		return OperationClassContainer, true
+	default:
+		return 0, false
+	}
+}
+
+// rateLimit is a token bucket configuration.
+type rateLimit struct {
+	rate  float64
+	burst int
+}
+
+// limiter restricts requests to the nodes according to the Pool limits.
+type limiter struct {
+	rates   [operationClassLast]*rate.Limiter // nil if unlimited
+	global  chan struct{}                     // nil if unlimited
+	perNode int
+
+	mtx   sync.Mutex
+	nodes map[string]chan struct{}
+
+	callback stat.LimitCallback
+}
+
+// newLimiter constructs limiter from the Pool parameters. Returns nil if no
+// limits are set.
+func newLimiter(params InitParameters) *limiter {
+	var (
+		res     limiter
+		limited bool
+	)
+	for i, l := range params.rateLimits {
+		if l.rate > 0 {
+			res.rates[i] = rate.NewLimiter(rate.Limit(l.rate), max(l.burst, 1))
+			limited = true
+		}
+	}
+	if params.maxInFlight > 0 {
+		res.global = make(chan struct{}, params.maxInFlight)
+		limited = true
+	}
+	if params.maxInFlightPerNode > 0 {
+		res.perNode = params.maxInFlightPerNode
+		res.nodes = make(map[string]chan struct{})
+		limited = true
+	}
+	if !limited {
+		return nil
+	}
+
+	res.callback = params.limitCallback
+	return &res
+}
+
+// acquire waits until the request of the given method to the node is allowed.
+// Waiting is interrupted with the context. Returned function releases
+// in-flight request slots, it must be called once the request is completed.
+func (x *limiter) acquire(ctx context.Context, endpoint string, m stat.Method) (func(), error) {
+	start := time.Now()
+	release, delayed, err := x.wait(ctx, endpoint, m)
+	if x.callback != nil && (delayed || err != nil) {
+		x.callback(endpoint, m, time.Since(start), err)
+	}
+	return release, err
+}
+
+func (x *limiter) wait(ctx context.Context, endpoint string, m stat.Method) (func(), bool, error) {
+	var delayed bool
+	if class, ok := methodClass(m); ok && x.rates[class] != nil {
+		if l := x.rates[class]; !l.Allow() {
+			delayed = true
+			if err := l.Wait(ctx); err != nil {
+				if ctx.Err() == nil {
+					// wait would exceed context deadline
+					err = context.DeadlineExceeded
+				}
+				return nil, delayed, fmt.Errorf("wait for %s rate limit: %w", class, err)
+			}
+		}
+	}
+
+	var sems []chan struct{}
+	release := func() {
+		for _, sem := range sems {
+			<-sem
+		}
+	}
+
+	if x.global != nil {
+		d, err := acquireSlot(ctx, x.global)
+		if delayed = delayed || d; err != nil {
+			return nil, delayed, fmt.Errorf("wait for in-flight requests limit: %w", err)
+		}
+		sems = append(sems, x.global)
+	}
+
+	if x.nodes != nil {
+		x.mtx.Lock()
+		sem, ok := x.nodes[endpoint]
+		if !ok {
+			sem = make(chan struct{}, x.perNode)
+			x.nodes[endpoint] = sem
+		}
+		x.mtx.Unlock()
+
+		d, err := acquireSlot(ctx, sem)
+		if delayed = delayed || d; err != nil {
+			release()
+			return nil, delayed, fmt.Errorf("wait for node in-flight requests limit: %w", err)
+		}
+		sems = append(sems, sem)
+	}
+
+	return sync.OnceFunc(release), delayed, nil
+}
+
+// acquireSlot takes slot of the semaphore waiting for it if needed.
+func acquireSlot(ctx context.Context, sem chan struct{}) (bool, error) {
+	select {
+	case sem <- struct{}{}:
+		return false, nil
+	default:
+	}
+
+	select {
+	case sem <- struct{}{}:
+		return true, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+// limitedClient is a sdkClientInterface executing requests within the Pool
+// limits.
+type limitedClient struct {
+	sdkClientInterface
+
+	limiter *limiter
+	addr    string
+}
+
+// limit returns client executing requests to the node with the given address
+// within the Pool limits.
+func (p *Pool) limit(c sdkClientInterface, addr string) sdkClientInterface {
+	if p.limiter == nil {
+		return c
+	}
+	return &limitedClient{sdkClientInterface: c, limiter: p.limiter, addr: addr}
+}
+
+func limited[T any](ctx context.Context, c *limitedClient, m stat.Method, op func() (T, error)) (T, error) {
+	release, err := c.limiter.acquire(ctx, c.addr, m)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	defer release()
+	return op()
+}
+
+func limitedErr(ctx context.Context, c *limitedClient, m stat.Method, op func() error) error {
+	_, err := limited(ctx, c, m, func() (struct{}, error) { return struct{}{}, op() })
+	return err
+}
+
+func (c *limitedClient) BalanceGet(ctx context.Context, prm sdkClient.PrmBalanceGet) (accounting.Decimal, error) {
+	return limited(ctx, c, stat.MethodBalanceGet, func() (accounting.Decimal, error) {
+		return c.sdkClientInterface.BalanceGet(ctx, prm)
+	})
+}
+
+func (c *limitedClient) ContainerPut(ctx context.Context, cont container.Container, signer neofscrypto.Signer, prm sdkClient.PrmContainerPut) (cid.ID, error) {
+	return limited(ctx, c, stat.MethodContainerPut, func() (cid.ID, error) {
+		return c.sdkClientInterface.ContainerPut(ctx, cont, signer, prm)
+	})
+}
+
+func (c *limitedClient) ContainerGet(ctx context.Context, id cid.ID, prm sdkClient.PrmContainerGet) (container.Container, error) {
+	return limited(ctx, c, stat.MethodContainerGet, func() (container.Container, error) {
+		return c.sdkClientInterface.ContainerGet(ctx, id, prm)
+	})
+}
+
+func (c *limitedClient) ContainerList(ctx context.Context, ownerID user.ID, prm sdkClient.PrmContainerList) ([]cid.ID, error) {
+	return limited(ctx, c, stat.MethodContainerList, func() ([]cid.ID, error) {
+		return c.sdkClientInterface.ContainerList(ctx, ownerID, prm)
+	})
+}
+
+func (c *limitedClient) ContainerDelete(ctx context.Context, id cid.ID, signer neofscrypto.Signer, prm sdkClient.PrmContainerDelete) error {
+	return limitedErr(ctx, c, stat.MethodContainerDelete, func() error {
+		return c.sdkClientInterface.ContainerDelete(ctx, id, signer, prm)
+	})
+}
+
+func (c *limitedClient) ContainerEACL(ctx context.Context, id cid.ID, prm sdkClient.PrmContainerEACL) (eacl.Table, error) {
+	return limited(ctx, c, stat.MethodContainerEACL, func() (eacl.Table, error) {
+		return c.sdkClientInterface.ContainerEACL(ctx, id, prm)
+	})
+}
+
+func (c *limitedClient) ContainerSetEACL(ctx context.Context, table eacl.Table, signer user.Signer, prm sdkClient.PrmContainerSetEACL) error {
+	return limitedErr(ctx, c, stat.MethodContainerSetEACL, func() error {
+		return c.sdkClientInterface.ContainerSetEACL(ctx, table, signer, prm)
+	})
+}
+
+func (c *limitedClient) SetContainerAttribute(ctx context.Context, prm sdkClient.SetContainerAttributeParameters, prmSig neofscrypto.Signature, opts sdkClient.SetContainerAttributeOptions) error {
+	return limitedErr(ctx, c, stat.MethodContainerSetAttribute, func() error {
+		return c.sdkClientInterface.SetContainerAttribute(ctx, prm, prmSig, opts)
+	})
+}
+
+func (c *limitedClient) RemoveContainerAttribute(ctx context.Context, prm sdkClient.RemoveContainerAttributeParameters, prmSig neofscrypto.Signature, opts sdkClient.RemoveContainerAttributeOptions) error {
+	return limitedErr(ctx, c, stat.MethodContainerRemoveAttribute, func() error {
+		return c.sdkClientInterface.RemoveContainerAttribute(ctx, prm, prmSig, opts)
+	})
+}
+
+func (c *limitedClient) NetworkInfo(ctx context.Context, prm sdkClient.PrmNetworkInfo) (netmap.NetworkInfo, error) {
+	return limited(ctx, c, stat.MethodNetworkInfo, func() (netmap.NetworkInfo, error) {
+		return c.sdkClientInterface.NetworkInfo(ctx, prm)
+	})
+}
+
+func (c *limitedClient) NetMapSnapshot(ctx context.Context, prm sdkClient.PrmNetMapSnapshot) (netmap.NetMap, error) {
+	return limited(ctx, c, stat.MethodNetMapSnapshot, func() (netmap.NetMap, error) {
+		return c.sdkClientInterface.NetMapSnapshot(ctx, prm)
+	})
+}
+
+// ObjectPutInit holds in-flight request slots until the returned writer is
+// closed.
+func (c *limitedClient) ObjectPutInit(ctx context.Context, hdr object.Object, signer user.Signer, prm sdkClient.PrmObjectPutInit) (sdkClient.ObjectWriter, error) {
+	release, err := c.limiter.acquire(ctx, c.addr, stat.MethodObjectPut)
+	if err != nil {
+		return nil, err
+	}
+	w, err := c.sdkClientInterface.ObjectPutInit(ctx, hdr, signer, prm)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &limitedObjectWriter{ObjectWriter: w, release: release}, nil
+}
+
+// ObjectGetInit holds in-flight request slots until the object header is
+// received only.
+func (c *limitedClient) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm sdkClient.PrmObjectGet) (object.Object, *sdkClient.PayloadReader, error) {
+	release, err := c.limiter.acquire(ctx, c.addr, stat.MethodObjectGet)
+	if err != nil {
+		return object.Object{}, nil, err
+	}
+	defer release()
+	return c.sdkClientInterface.ObjectGetInit(ctx, containerID, objectID, signer, prm)
+}
+
+func (c *limitedClient) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm sdkClient.PrmObjectHead) (*object.Object, error) {
+	return limited(ctx, c, stat.MethodObjectHead, func() (*object.Object, error) {
+		return c.sdkClientInterface.ObjectHead(ctx, containerID, objectID, signer, prm)
+	})
+}
+
+// ObjectRangeInit holds in-flight request slots until the stream is opened
+// only.
+func (c *limitedClient) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm sdkClient.PrmObjectRange) (*sdkClient.ObjectRangeReader, error) {
+	return limited(ctx, c, stat.MethodObjectRange, func() (*sdkClient.ObjectRangeReader, error) {
+		return c.sdkClientInterface.ObjectRangeInit(ctx, containerID, objectID, offset, length, signer, prm)
+	})
+}
+
+func (c *limitedClient) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm sdkClient.PrmObjectDelete) (oid.ID, error) {
+	return limited(ctx, c, stat.MethodObjectDelete, func() (oid.ID, error) {
+		return c.sdkClientInterface.ObjectDelete(ctx, containerID, objectID, signer, prm)
+	})
+}
+
+func (c *limitedClient) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, ranges []object.Range, typ checksum.Type, salt []byte, signer user.Signer, prm sdkClient.PrmObjectHash) ([]checksum.Checksum, error) {
+	return limited(ctx, c, stat.MethodObjectHash, func() ([]checksum.Checksum, error) {
+		return c.sdkClientInterface.ObjectHash(ctx, containerID, objectID, ranges, typ, salt, signer, prm)
+	})
+}
+
+// ObjectSearchInit holds in-flight request slots until the stream is opened
+// only.
+func (c *limitedClient) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm sdkClient.PrmObjectSearch) (*sdkClient.ObjectListReader, error) {
+	return limited(ctx, c, stat.MethodObjectSearch, func() (*sdkClient.ObjectListReader, error) {
+		return c.sdkClientInterface.ObjectSearchInit(ctx, containerID, signer, prm)
+	})
+}
+
+func (c *limitedClient) SearchObjects(ctx context.Context, containerID cid.ID, filters object.SearchFilters, attrs []string, cursor string, signer neofscrypto.Signer, opts sdkClient.SearchObjectsOptions) ([]sdkClient.SearchResultItem, string, error) {
+	release, err := c.limiter.acquire(ctx, c.addr, stat.MethodObjectSearchV2)
+	if err != nil {
+		return nil, "", err
+	}
+	defer release()
+	return c.sdkClientInterface.SearchObjects(ctx, containerID, filters, attrs, cursor, signer, opts)
+}
+
+func (c *limitedClient) SessionCreate(ctx context.Context, signer user.Signer, prm sdkClient.PrmSessionCreate) (*sdkClient.ResSessionCreate, error) {
+	return limited(ctx, c, stat.MethodSessionCreate, func() (*sdkClient.ResSessionCreate, error) {
+		return c.sdkClientInterface.SessionCreate(ctx, signer, prm)
+	})
+}
+
+func (c *limitedClient) EndpointInfo(ctx context.Context, prm sdkClient.PrmEndpointInfo) (*sdkClient.ResEndpointInfo, error) {
+	return limited(ctx, c, stat.MethodEndpointInfo, func() (*sdkClient.ResEndpointInfo, error) {
+		return c.sdkClientInterface.EndpointInfo(ctx, prm)
+	})
+}
+
+// limitedObjectWriter is a [sdkClient.ObjectWriter] releasing in-flight request
+// slots on close.
+type limitedObjectWriter struct {
+	sdkClient.ObjectWriter
+	release func()
+}
+
+func (x *limitedObjectWriter) Close() error {
+	defer x.release()
+	return x.ObjectWriter.Close()
+}
@@ -0,0 +1,188 @@
+package pool
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/container/acl"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+type limitEvent struct {
+	endpoint string
+	method   stat.Method
+	err      error
+}
+
+type limitRecorder struct {
+	mtx    sync.Mutex
+	events []limitEvent
+}
+
+func (x *limitRecorder) callback(endpoint string, method stat.Method, wait time.Duration, err error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	x.events = append(x.events, limitEvent{endpoint: endpoint, method: method, err: err})
+}
+
+func (x *limitRecorder) get() []limitEvent {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+	return x.events
+}
+
+func timeoutCtx(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestLimiter(t *testing.T) {
+	require.Nil(t, newLimiter(InitParameters{}))
+
+	t.Run("in-flight per node", func(t *testing.T) {
+		var (
+			rec  limitRecorder
+			opts InitParameters
+		)
+		opts.SetMaxInFlightPerNode(1)
+		opts.SetLimitCallback(rec.callback)
+		l := newLimiter(opts)
+
+		release, err := l.acquire(t.Context(), "a", stat.MethodObjectHead)
+		require.NoError(t, err)
+		releaseB, err := l.acquire(t.Context(), "b", stat.MethodObjectHead)
+		require.NoError(t, err)
+		require.Empty(t, rec.get())
+
+		_, err = l.acquire(timeoutCtx(t), "a", stat.MethodObjectGet)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		events := rec.get()
+		require.Len(t, events, 1)
+		require.Equal(t, "a", events[0].endpoint)
+		require.Equal(t, stat.MethodObjectGet, events[0].method)
+		require.ErrorIs(t, events[0].err, context.DeadlineExceeded)
+
+		release()
+		release() // must be no-op
+		release, err = l.acquire(t.Context(), "a", stat.MethodObjectGet)
+		require.NoError(t, err)
+		_, err = l.acquire(timeoutCtx(t), "a", stat.MethodObjectGet)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		release()
+		releaseB()
+	})
+
+	t.Run("global in-flight", func(t *testing.T) {
+		var (
+			rec  limitRecorder
+			opts InitParameters
+		)
+		opts.SetMaxInFlight(1)
+		opts.SetLimitCallback(rec.callback)
+		l := newLimiter(opts)
+
+		release, err := l.acquire(t.Context(), "a", stat.MethodObjectHead)
+		require.NoError(t, err)
+		time.AfterFunc(10*time.Millisecond, release)
+
+		release, err = l.acquire(t.Context(), "b", stat.MethodContainerGet)
+		require.NoError(t, err)
+		require.Equal(t, []limitEvent{{endpoint: "b", method: stat.MethodContainerGet}}, rec.get())
+
+		_, err = l.acquire(timeoutCtx(t), "c", stat.MethodBalanceGet)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		release()
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		var (
+			rec  limitRecorder
+			opts InitParameters
+		)
+		opts.SetRateLimit(OperationClassObjectWrite, 0.001, 2)
+		opts.SetLimitCallback(rec.callback)
+		l := newLimiter(opts)
+
+		for range 2 {
+			release, err := l.acquire(t.Context(), "a", stat.MethodObjectPut)
+			require.NoError(t, err)
+			release()
+		}
+		_, err := l.acquire(timeoutCtx(t), "b", stat.MethodObjectDelete)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Len(t, rec.get(), 1)
+
+		// other classes are not limited
+		for _, m := range []stat.Method{stat.MethodObjectHead, stat.MethodContainerPut, stat.MethodNetworkInfo} {
+			release, err := l.acquire(timeoutCtx(t), "a", m)
+			require.NoError(t, err)
+			release()
+		}
+		require.Len(t, rec.get(), 1)
+	})
+
+	require.Panics(t, func() {
+		var opts InitParameters
+		opts.SetRateLimit(operationClassLast, 1, 1)
+	})
+}
+
+func TestPool_Limits(t *testing.T) {
+	network := neofstest.NewNetwork(1)
+	t.Cleanup(network.Stop)
+	usr := usertest.User()
+	ps := stat.NewPoolStatistic()
+
+	var opts InitParameters
+	opts.SetSigner(usr.RFC6979)
+	opts.SetDialFunc(network.Dial)
+	opts.SetMaxInFlightPerNode(1)
+	opts.SetLimitCallback(ps.LimitCallback)
+	opts.AddNode(NewNodeParam(1, network.Endpoints()[0], 1))
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(t.Context()))
+	t.Cleanup(func() { _ = p.Close() })
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 1"))
+	var cnr container.Container
+	cnr.Init()
+	cnr.SetOwner(usr.ID)
+	cnr.SetBasicACL(acl.PublicRW)
+	cnr.SetPlacementPolicy(policy)
+
+	var hdr object.Object
+	hdr.SetContainerID(network.Nodes()[0].PutContainer(cnr))
+	hdr.SetOwner(usr.ID)
+
+	w, err := p.ObjectPutInit(t.Context(), hdr, usr, client.PrmObjectPutInit{})
+	require.NoError(t, err)
+
+	_, err = p.ObjectPutInit(timeoutCtx(t), hdr, usr, client.PrmObjectPutInit{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = w.Write([]byte("Hello, world!"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	w, err = p.ObjectPutInit(t.Context(), hdr, usr, client.PrmObjectPutInit{})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	l, err := ps.Statistic().Limit(stat.MethodObjectPut)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, l.Delayed())
+	require.EqualValues(t, 1, l.Rejected())
+	require.Positive(t, l.WaitTime())
+}
@@ -3,11 +3,15 @@ package pool
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
@@ -19,6 +23,8 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockClient struct {
@@ -33,6 +39,13 @@ type mockClient struct {
 	errorOnNetworkInfo   bool
 	errOnGetObject       error
 	errOnPutObject       error
+	errOnHeadObject      error
+	headDelay            time.Duration
+	headsCancelled       atomic.Int32
+
+	netMap        *netmap.NetMap
+	container     *container.Container
+	containerGets *atomic.Int32
 }
 
 func (m *mockClient) Dial(_ client.PrmDial) error {
@@ -53,8 +66,13 @@ func (m *mockClient) ContainerPut(_ context.Context, _ container.Container, _ ne
 }
 
 func (m *mockClient) ContainerGet(_ context.Context, _ cid.ID, _ client.PrmContainerGet) (container.Container, error) {
-	// TODO implement me
-	panic("implement me")
+	if m.container == nil {
+		return container.Container{}, apistatus.ErrContainerNotFound
+	}
+	if m.containerGets != nil {
+		m.containerGets.Add(1)
+	}
+	return *m.container, nil
 }
 
 func (m *mockClient) ContainerList(_ context.Context, _ user.ID, _ client.PrmContainerList) ([]cid.ID, error) {
@@ -102,8 +120,10 @@ func (m *mockClient) NetworkInfo(_ context.Context, _ client.PrmNetworkInfo) (ne
 }
 
 func (m *mockClient) NetMapSnapshot(_ context.Context, _ client.PrmNetMapSnapshot) (netmap.NetMap, error) {
-	// TODO implement me
-	panic("implement me")
+	if m.netMap == nil {
+		return netmap.NetMap{}, status.Error(codes.Unavailable, "network map is unavailable")
+	}
+	return *m.netMap, nil
 }
 
 func (m *mockClient) ObjectPutInit(_ context.Context, _ object.Object, _ user.Signer, _ client.PrmObjectPutInit) (client.ObjectWriter, error) {
@@ -118,9 +138,29 @@ func (m *mockClient) ObjectGetInit(_ context.Context, _ cid.ID, _ oid.ID, _ user
 	return hdr, &pl, m.errOnGetObject
 }
 
-func (m *mockClient) ObjectHead(_ context.Context, _ cid.ID, _ oid.ID, _ user.Signer, _ client.PrmObjectHead) (*object.Object, error) {
-	// TODO implement me
-	panic("implement me")
+func (m *mockClient) ObjectHead(ctx context.Context, _ cid.ID, _ oid.ID, _ user.Signer, _ client.PrmObjectHead) (*object.Object, error) {
+	if m.headDelay > 0 {
+		t := time.NewTimer(m.headDelay)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+			m.headsCancelled.Add(1)
+			// same as gRPC client does
+			err := fmt.Errorf("rpc failure: %w", status.Error(codes.Canceled, ctx.Err().Error()))
+			m.updateErrorRate(err)
+			return nil, err
+		case <-t.C:
+		}
+	}
+
+	m.updateErrorRate(m.errOnHeadObject)
+	if m.errOnHeadObject != nil {
+		return nil, m.errOnHeadObject
+	}
+
+	var hdr object.Object
+	hdr.SetAttributes(object.NewAttribute("Node", m.addr))
+	return &hdr, nil
 }
 
 func (m *mockClient) ObjectRangeInit(_ context.Context, _ cid.ID, _ oid.ID, _, _ uint64, _ user.Signer, _ client.PrmObjectRange) (*client.ObjectRangeReader, error) {
@@ -132,6 +172,11 @@ func (m *mockClient) ObjectDelete(_ context.Context, _ cid.ID, _ oid.ID, _ user.
 	return oid.ID{}, nil
 }
 
+func (m *mockClient) ObjectHash(_ context.Context, _ cid.ID, _ oid.ID, _ []object.Range, _ checksum.Type, _ []byte, _ user.Signer, _ client.PrmObjectHash) ([]checksum.Checksum, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (m *mockClient) ObjectSearchInit(_ context.Context, _ cid.ID, _ user.Signer, _ client.PrmObjectSearch) (*client.ObjectListReader, error) {
 	// TODO implement me
 	panic("implement me")
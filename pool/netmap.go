@@ -4,29 +4,51 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
 )
 
 // NetworkInfo requests information about the NeoFS network of which the remote server is a part.
 //
 // See details in [client.Client.NetworkInfo].
 func (p *Pool) NetworkInfo(ctx context.Context, prm client.PrmNetworkInfo) (netmap.NetworkInfo, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodNetworkInfo)
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return netmap.NetworkInfo{}, err
 	}
 
-	return c.NetworkInfo(ctx, prm)
+	var res netmap.NetworkInfo
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.NetworkInfo(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // NetMapSnapshot requests current network view of the remote server.
 //
 // See details in [client.Client.NetMapSnapshot].
 func (p *Pool) NetMapSnapshot(ctx context.Context, prm client.PrmNetMapSnapshot) (netmap.NetMap, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodNetMapSnapshot)
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return netmap.NetMap{}, err
 	}
 
-	return c.NetMapSnapshot(ctx, prm)
+	var res netmap.NetMap
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, err = c.NetMapSnapshot(ctx, prm)
+		return err
+	})
+
+	return res, err
 }
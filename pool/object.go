@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	sessionv2 "github.com/nspcc-dev/neofs-sdk-go/session/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 )
 
@@ -36,6 +39,10 @@ func (p *Pool) actualSigner(signer user.Signer) user.Signer {
 //
 // See details in [client.Client.ObjectPutInit].
 func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user.Signer, prm client.PrmObjectPutInit) (client.ObjectWriter, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectPut, telemetry.ContainerID(hdr.GetContainerID()))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return nil, err
@@ -43,7 +50,8 @@ func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user
 
 	cnr := hdr.GetContainerID()
 	if cnr.IsZero() {
-		return nil, cid.ErrZero
+		err = cid.ErrZero
+		return nil, err
 	}
 
 	if err = p.withinContainerSession(
@@ -54,7 +62,8 @@ func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user
 		session.VerbObjectPut,
 		&prm,
 	); err != nil {
-		return nil, fmt.Errorf("session: %w", err)
+		err = fmt.Errorf("session: %w", err)
+		return nil, err
 	}
 
 	ow, err := c.ObjectPutInit(ctx, hdr, signer, prm)
@@ -69,11 +78,37 @@ func (p *Pool) ObjectPutInit(ctx context.Context, hdr object.Object, signer user
 //
 // See details in [client.Client.ObjectGetInit].
 func (p *Pool) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectGet) (object.Object, *client.PayloadReader, error) {
-	c, err := p.sdkClient()
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectGet, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+
+	c, err := p.objectClient(ctx, containerID, objectID)
 	if err != nil {
 		return object.Object{}, nil, err
 	}
-	return c.ObjectGetInit(ctx, containerID, objectID, signer, prm)
+
+	type getResult struct {
+		hdr object.Object
+		rdr *client.PayloadReader
+	}
+
+	var res getResult
+	next := func(exclude ...string) (*sdkClientWrapper, error) {
+		return p.objectClient(ctx, containerID, objectID, exclude...)
+	}
+	err = p.retry(ctx, c, next, func(c *sdkClientWrapper) (err error) {
+		var cancel context.CancelFunc
+		res, cancel, err = hedgedRead(ctx, p, c, stat.MethodObjectGet, next, func(ctx context.Context, c *sdkClientWrapper) (res getResult, err error) {
+			res.hdr, res.rdr, err = c.ObjectGetInit(ctx, containerID, objectID, signer, prm)
+			return res, err
+		}, func(res getResult) { _ = res.rdr.Close() })
+		if err == nil {
+			res.rdr.OnClose(cancel)
+		}
+		return err
+	})
+
+	return res.hdr, res.rdr, err
 }
 
 // ObjectHead reads object header through a remote server using NeoFS API protocol.
@@ -82,11 +117,29 @@ func (p *Pool) ObjectGetInit(ctx context.Context, containerID cid.ID, objectID o
 //
 // See details in [client.Client.ObjectHead].
 func (p *Pool) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*object.Object, error) {
-	c, err := p.sdkClient()
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectHead, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+
+	c, err := p.objectClient(ctx, containerID, objectID)
 	if err != nil {
 		return nil, err
 	}
-	return c.ObjectHead(ctx, containerID, objectID, signer, prm)
+
+	var res *object.Object
+	next := func(exclude ...string) (*sdkClientWrapper, error) {
+		return p.objectClient(ctx, containerID, objectID, exclude...)
+	}
+	err = p.retry(ctx, c, next, func(c *sdkClientWrapper) (err error) {
+		var cancel context.CancelFunc
+		res, cancel, err = hedgedRead(ctx, p, c, stat.MethodObjectHead, next, func(ctx context.Context, c *sdkClientWrapper) (*object.Object, error) {
+			return c.ObjectHead(ctx, containerID, objectID, signer, prm)
+		}, nil)
+		cancel()
+		return err
+	})
+
+	return res, err
 }
 
 // ObjectRangeInit initiates reading an object's payload range through a remote
@@ -96,11 +149,43 @@ func (p *Pool) ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.
 //
 // See details in [client.Client.ObjectRangeInit].
 func (p *Pool) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm client.PrmObjectRange) (*client.ObjectRangeReader, error) {
-	c, err := p.sdkClient()
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectRange, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+
+	c, err := p.objectClient(ctx, containerID, objectID)
 	if err != nil {
 		return nil, err
 	}
-	return c.ObjectRangeInit(ctx, containerID, objectID, offset, length, signer, prm)
+	var r *client.ObjectRangeReader
+	r, err = c.ObjectRangeInit(ctx, containerID, objectID, offset, length, signer, prm)
+	return r, err
+}
+
+// ObjectHash requests checksums of object payload ranges through a remote
+// server using NeoFS API protocol.
+//
+// See details in [client.Client.ObjectHash].
+func (p *Pool) ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, ranges []object.Range, typ checksum.Type, salt []byte, signer user.Signer, prm client.PrmObjectHash) ([]checksum.Checksum, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectHash, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+
+	c, err := p.objectClient(ctx, containerID, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []checksum.Checksum
+	next := func(exclude ...string) (*sdkClientWrapper, error) {
+		return p.objectClient(ctx, containerID, objectID, exclude...)
+	}
+	err = p.retry(ctx, c, next, func(c *sdkClientWrapper) (err error) {
+		res, err = c.ObjectHash(ctx, containerID, objectID, ranges, typ, salt, signer, prm)
+		return err
+	})
+
+	return res, err
 }
 
 // ObjectDelete marks an object for deletion from the container using NeoFS API protocol.
@@ -109,6 +194,10 @@ func (p *Pool) ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID
 //
 // See details in [client.Client.ObjectDelete].
 func (p *Pool) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectDelete) (oid.ID, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectDelete, telemetry.ContainerID(containerID), telemetry.ObjectID(objectID))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return oid.ID{}, err
@@ -121,7 +210,8 @@ func (p *Pool) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oi
 		session.VerbObjectDelete,
 		&prm,
 	); err != nil {
-		return oid.ID{}, fmt.Errorf("session: %w", err)
+		err = fmt.Errorf("session: %w", err)
+		return oid.ID{}, err
 	}
 
 	id, err := c.ObjectDelete(ctx, containerID, objectID, signer, prm)
@@ -136,20 +226,40 @@ func (p *Pool) ObjectDelete(ctx context.Context, containerID cid.ID, objectID oi
 //
 // See details in [client.Client.ObjectSearchInit].
 func (p *Pool) ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm client.PrmObjectSearch) (*client.ObjectListReader, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectSearch, telemetry.ContainerID(containerID))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return nil, err
 	}
-	return c.ObjectSearchInit(ctx, containerID, signer, prm)
+	var r *client.ObjectListReader
+	r, err = c.ObjectSearchInit(ctx, containerID, signer, prm)
+	return r, err
 }
 
 // SearchObjects selects a suitable connection from the pool and calls
 // [client.Client.SearchObjects] on it.
 func (p *Pool) SearchObjects(ctx context.Context, containerID cid.ID, filters object.SearchFilters, attrs []string, cursor string,
 	signer neofscrypto.Signer, opts client.SearchObjectsOptions) ([]client.SearchResultItem, string, error) {
+	var err error
+	ctx, span := p.tracer.Start(ctx, stat.MethodObjectSearchV2, telemetry.ContainerID(containerID))
+	defer func() { telemetry.End(span, err) }()
+
 	c, err := p.sdkClient()
 	if err != nil {
 		return nil, "", err
 	}
-	return c.SearchObjects(ctx, containerID, filters, attrs, cursor, signer, opts)
+
+	var (
+		res        []client.SearchResultItem
+		nextCursor string
+	)
+	err = p.retry(ctx, c, p.sdkClient, func(c *sdkClientWrapper) (err error) {
+		res, nextCursor, err = c.SearchObjects(ctx, containerID, filters, attrs, cursor, signer, opts)
+		return err
+	})
+
+	return res, nextCursor, err
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
 	bearertest "github.com/nspcc-dev/neofs-sdk-go/bearer/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -96,6 +97,10 @@ func (noOtherClientCalls) ObjectDelete(context.Context, cid.ID, oid.ID, user.Sig
 	panic("must not be called")
 }
 
+func (noOtherClientCalls) ObjectHash(context.Context, cid.ID, oid.ID, []object.Range, checksum.Type, []byte, user.Signer, client.PrmObjectHash) ([]checksum.Checksum, error) {
+	panic("must not be called")
+}
+
 func (noOtherClientCalls) ObjectSearchInit(context.Context, cid.ID, user.Signer, client.PrmObjectSearch) (*client.ObjectListReader, error) {
 	panic("must not be called")
 }
@@ -391,6 +396,103 @@ func TestPool_ObjectRangeInit(t *testing.T) {
 	require.Equal(t, pld, rangeClient.pld)
 }
 
+type objectHashOnlyClient struct {
+	noOtherClientCalls
+	// expected input
+	cnr    cid.ID
+	objID  oid.ID
+	ranges []object.Range
+	typ    checksum.Type
+	salt   []byte
+	sgnr   user.Signer
+	opts   client.PrmObjectHash
+	// ret
+	res []checksum.Checksum
+	err error
+}
+
+func (x objectHashOnlyClient) ObjectHash(ctx context.Context, cnr cid.ID, objID oid.ID, ranges []object.Range, typ checksum.Type, salt []byte, signer user.Signer, opts client.PrmObjectHash) ([]checksum.Checksum, error) {
+	switch {
+	case ctx == nil:
+		return nil, errors.New("[test] nil context")
+	case cnr != x.cnr:
+		return nil, errors.New("[test] wrong container")
+	case objID != x.objID:
+		return nil, errors.New("[test] wrong object ID")
+	case !assert.ObjectsAreEqual(ranges, x.ranges):
+		return nil, errors.New("[test] wrong ranges")
+	case typ != x.typ:
+		return nil, errors.New("[test] wrong checksum type")
+	case !assert.ObjectsAreEqual(salt, x.salt):
+		return nil, errors.New("[test] wrong salt")
+	case !assert.ObjectsAreEqual(signer, x.sgnr):
+		return nil, errors.New("[test] wrong signer")
+	case !assert.ObjectsAreEqual(opts, x.opts):
+		return nil, errors.New("[test] wrong options")
+	}
+	return x.res, x.err
+}
+
+type objectHashOnlyClientWrapper struct {
+	mockedClientWrapper
+	c objectHashOnlyClient
+}
+
+func (x objectHashOnlyClientWrapper) getClient() (sdkClientInterface, error) { return x.c, nil }
+
+func TestPool_ObjectHash(t *testing.T) {
+	ctx := context.Background()
+	cnrID := cidtest.ID()
+	objID := oidtest.ID()
+	usr := usertest.User()
+
+	var rng object.Range
+	rng.SetOffset(13)
+	rng.SetLength(42)
+
+	var hashOpts client.PrmObjectHash
+	hashOpts.WithBearerToken(bearertest.Token())
+	hashOpts.MarkLocal()
+	hashOpts.WithXHeaders("k1", "v1", "k2", "v2")
+
+	hashClient := objectHashOnlyClient{
+		cnr:    cnrID,
+		objID:  objID,
+		ranges: []object.Range{rng},
+		typ:    checksum.SHA256,
+		salt:   []byte("any salt"),
+		sgnr:   usr,
+		opts:   hashOpts,
+		res:    []checksum.Checksum{checksum.NewSHA256([32]byte{1, 2, 3})},
+		err:    errors.New("any error"),
+	}
+	endpoints := []string{"localhost:8080", "localhost:8081"}
+	nodes := make([]NodeParam, len(endpoints))
+	cws := make([]objectHashOnlyClientWrapper, len(endpoints))
+	for i := range endpoints {
+		nodes[i].address = endpoints[i]
+		cws[i].addr = endpoints[i]
+		cws[i].c = hashClient
+	}
+
+	var poolOpts InitParameters
+	poolOpts.setClientBuilder(func(endpoint string) (internalClient, error) {
+		ind := slices.Index(endpoints, endpoint)
+		if ind < 0 {
+			return nil, fmt.Errorf("unexpected endpoint %q", endpoint)
+		}
+		return &cws[ind], nil
+	})
+	p, err := New(nodes, usertest.User().RFC6979, poolOpts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(ctx))
+	t.Cleanup(func() { _ = p.Close })
+
+	res, err := p.ObjectHash(context.Background(), cnrID, objID, hashClient.ranges, hashClient.typ, hashClient.salt, usr, hashOpts)
+	require.Equal(t, err, hashClient.err)
+	require.Equal(t, res, hashClient.res)
+}
+
 type objectSearchOnlyClient struct {
 	noOtherClientCalls
 	// expected input
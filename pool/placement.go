@@ -0,0 +1,280 @@
+package pool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/uriutil"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultNetMapRefreshInterval = time.Minute
+	defaultPlacementCacheSize    = 1000
+)
+
+// containerPlacement is a cached placement of the container nodes.
+type containerPlacement struct {
+	policy netmap.PlacementPolicy
+	// epoch of the network map nodes are selected from.
+	epoch uint64
+	nodes [][]netmap.NodeInfo
+
+	// failure of the container request and its time. Failed requests are not
+	// repeated for the refresh interval, see placementRouter.containerNodes.
+	err     error
+	errTime time.Time
+}
+
+// placementRouter selects pool connections to the nodes storing particular
+// objects according to the container placement policy applied to the current
+// network map.
+type placementRouter struct {
+	logger          *zap.Logger
+	refreshInterval time.Duration
+	// timeout of the network map request made in background.
+	refreshTimeout time.Duration
+	// pool connections by normalized endpoints.
+	clients map[string]internalClient
+
+	getNetMap    func(context.Context) (netmap.NetMap, error)
+	getContainer func(context.Context, cid.ID) (container.Container, error)
+
+	mtx        sync.Mutex
+	netMap     *netmap.NetMap
+	netMapTime time.Time
+	// closed when the network map refresh in progress finishes, nil if there is
+	// no such refresh.
+	netMapRefresh chan struct{}
+	// error of the last network map refresh.
+	netMapErr  error
+	containers *lru.Cache[cid.ID, *containerPlacement]
+}
+
+func newPlacementRouter(refreshInterval, refreshTimeout time.Duration, logger *zap.Logger) (*placementRouter, error) {
+	cache, err := lru.New[cid.ID, *containerPlacement](defaultPlacementCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshInterval <= 0 {
+		refreshInterval = defaultNetMapRefreshInterval
+	}
+
+	return &placementRouter{
+		logger:          logger,
+		refreshInterval: refreshInterval,
+		refreshTimeout:  refreshTimeout,
+		clients:         make(map[string]internalClient),
+		containers:      cache,
+	}, nil
+}
+
+// addClient registers pool connection to be used for routing.
+func (r *placementRouter) addClient(c internalClient) {
+	if key, ok := endpointKey(c.address()); ok {
+		r.clients[key] = c
+	}
+}
+
+// currentNetMap returns cached network map refreshing it if needed. Refresh is
+// done in background, and outdated network map is returned meanwhile. If there
+// is no cached network map yet, currentNetMap waits for the refresh or ctx.
+func (r *placementRouter) currentNetMap(ctx context.Context) (*netmap.NetMap, error) {
+	r.mtx.Lock()
+	nm := r.netMap
+	if nm != nil && time.Since(r.netMapTime) < r.refreshInterval {
+		r.mtx.Unlock()
+		return nm, nil
+	}
+
+	done := r.netMapRefresh
+	if done == nil {
+		done = make(chan struct{})
+		r.netMapRefresh = done
+		go r.refreshNetMap(done)
+	}
+	r.mtx.Unlock()
+
+	if nm != nil {
+		// outdated network map is still better than nothing
+		return nm, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("get network map: %w", ctx.Err())
+	case <-done:
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.netMap == nil {
+		return nil, fmt.Errorf("get network map: %w", r.netMapErr)
+	}
+	return r.netMap, nil
+}
+
+// refreshNetMap requests the current network map and caches it. Closes done
+// when finished.
+func (r *placementRouter) refreshNetMap(done chan struct{}) {
+	// context of the request which triggered the refresh may be done before,
+	// while the result is shared with all waiting requests
+	ctx, cancel := context.WithTimeout(context.Background(), r.refreshTimeout)
+	defer cancel()
+
+	nm, err := r.getNetMap(ctx)
+
+	r.mtx.Lock()
+	if err != nil {
+		r.netMapErr = err
+		if r.netMap != nil && r.logger != nil {
+			r.logger.Warn("failed to refresh network map, using cached one", zap.Error(err))
+		}
+	} else {
+		r.netMap = &nm
+		r.netMapTime = time.Now()
+	}
+	r.netMapRefresh = nil
+	r.mtx.Unlock()
+
+	close(done)
+}
+
+// containerNodes returns container nodes selected from the given network map.
+// Container request failure is cached for the refresh interval, so requests
+// for the objects of such container fall back to regular connection selection
+// immediately.
+func (r *placementRouter) containerNodes(ctx context.Context, nm *netmap.NetMap, cnr cid.ID) ([][]netmap.NodeInfo, error) {
+	r.mtx.Lock()
+	cp, ok := r.containers.Get(cnr)
+	if ok {
+		if cp.err != nil {
+			if time.Since(cp.errTime) < r.refreshInterval {
+				err := cp.err
+				r.mtx.Unlock()
+				return nil, err
+			}
+			ok = false
+		} else if cp.epoch == nm.Epoch() && cp.nodes != nil {
+			nodes := cp.nodes
+			r.mtx.Unlock()
+			return nodes, nil
+		}
+	}
+	r.mtx.Unlock()
+
+	if !ok {
+		c, err := r.getContainer(ctx, cnr)
+		if err != nil {
+			err = fmt.Errorf("get container: %w", err)
+			if ctx.Err() == nil { // failure is not caused by the particular request
+				r.mtx.Lock()
+				r.containers.Add(cnr, &containerPlacement{err: err, errTime: time.Now()})
+				r.mtx.Unlock()
+			}
+			return nil, err
+		}
+		cp = &containerPlacement{policy: c.PlacementPolicy()}
+	}
+
+	nodes, err := nm.ContainerNodes(cp.policy, cnr)
+	if err != nil {
+		return nil, fmt.Errorf("apply placement policy: %w", err)
+	}
+
+	r.mtx.Lock()
+	r.containers.Add(cnr, &containerPlacement{policy: cp.policy, epoch: nm.Epoch(), nodes: nodes})
+	r.mtx.Unlock()
+
+	return nodes, nil
+}
+
+// connection returns healthy pool connection to the node which should store
+// the referenced object skipping the excluded addresses. Returns nil if there
+// is no such connection or placement cannot be calculated.
+func (r *placementRouter) connection(ctx context.Context, cnr cid.ID, obj oid.ID, exclude ...string) internalClient {
+	nm, err := r.currentNetMap(ctx)
+	if err == nil {
+		var nodes [][]netmap.NodeInfo
+		if nodes, err = r.containerNodes(ctx, nm, cnr); err == nil {
+			if nodes, err = nm.PlacementVectors(nodes, obj); err == nil {
+				return r.pick(nodes, exclude)
+			}
+		}
+	}
+
+	if r.logger != nil {
+		r.logger.Debug("failed to route object request by placement",
+			zap.Stringer("container", cnr), zap.Stringer("object", obj), zap.Error(err))
+	}
+
+	return nil
+}
+
+// pick returns the first healthy connection to the node from the given
+// placement vectors. Vectors are traversed rank by rank, so the primary nodes
+// of all vectors are tried first. Connections with the excluded addresses are
+// skipped.
+func (r *placementRouter) pick(vectors [][]netmap.NodeInfo, exclude []string) internalClient {
+	for rank := 0; ; rank++ {
+		var more bool
+		for i := range vectors {
+			if rank >= len(vectors[i]) {
+				continue
+			}
+			more = true
+
+			for ep := range vectors[i][rank].NetworkEndpoints() {
+				key, ok := endpointKey(ep)
+				if !ok {
+					continue
+				}
+				if c, ok := r.clients[key]; ok && c.isHealthy() && !slices.Contains(exclude, c.address()) {
+					return c
+				}
+			}
+		}
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// endpointKey normalizes network endpoint announced by the storage node or
+// passed to the pool. Both URI and multiaddr forms are supported.
+func endpointKey(s string) (string, bool) {
+	if strings.HasPrefix(s, "/") {
+		// multiaddr, e.g. /dns4/localhost/tcp/8080/tls
+		parts := strings.Split(s[1:], "/")
+		if len(parts) < 4 || parts[2] != "tcp" {
+			return "", false
+		}
+
+		switch parts[0] {
+		case "ip4", "ip6", "dns", "dns4", "dns6":
+			return strings.ToLower(net.JoinHostPort(parts[1], parts[3])), true
+		default:
+			return "", false
+		}
+	}
+
+	host, _, err := uriutil.Parse(s)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.ToLower(host), true
+}
@@ -0,0 +1,352 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointKey(t *testing.T) {
+	for _, tc := range []struct {
+		in, out string
+		ok      bool
+	}{
+		{in: "peer0:8080", out: "peer0:8080", ok: true},
+		{in: "grpc://Peer0:8080", out: "peer0:8080", ok: true},
+		{in: "grpcs://peer0:8080", out: "peer0:8080", ok: true},
+		{in: "/dns4/peer0/tcp/8080", out: "peer0:8080", ok: true},
+		{in: "/dns4/peer0/tcp/8080/tls", out: "peer0:8080", ok: true},
+		{in: "/ip4/127.0.0.1/tcp/8080", out: "127.0.0.1:8080", ok: true},
+		{in: "/ip6/::1/tcp/8080", out: "[::1]:8080", ok: true},
+		{in: "grpc://[::1]:8080", out: "[::1]:8080", ok: true},
+		{in: "/ip4/127.0.0.1/udp/8080"},
+		{in: "/unix/socket"},
+		{in: "http://peer0:8080"},
+		{in: "peer0"},
+	} {
+		res, ok := endpointKey(tc.in)
+		require.Equal(t, tc.ok, ok, tc.in)
+		require.Equal(t, tc.out, res, tc.in)
+	}
+}
+
+func TestPlacementRouter_currentNetMap(t *testing.T) {
+	newRouter := func(t *testing.T) (*placementRouter, chan netmap.NetMap, *atomic.Int32) {
+		r, err := newPlacementRouter(time.Millisecond, time.Minute, nil)
+		require.NoError(t, err)
+		var (
+			requests atomic.Int32
+			ch       = make(chan netmap.NetMap)
+		)
+		r.getNetMap = func(ctx context.Context) (netmap.NetMap, error) {
+			requests.Add(1)
+			select {
+			case nm := <-ch:
+				if nm.Epoch() == 0 {
+					return nm, errors.New("any error")
+				}
+				return nm, nil
+			case <-ctx.Done():
+				return netmap.NetMap{}, ctx.Err()
+			}
+		}
+		return r, ch, &requests
+	}
+
+	t.Run("first", func(t *testing.T) {
+		r, ch, requests := newRouter(t)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		t.Cleanup(cancel)
+		_, err := r.currentNetMap(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		// cancelled request does not break the refresh shared with others
+		var nm netmap.NetMap
+		nm.SetEpoch(13)
+		go func() { ch <- nm }()
+		res, err := r.currentNetMap(t.Context())
+		require.NoError(t, err)
+		require.EqualValues(t, 13, res.Epoch())
+		require.EqualValues(t, 1, requests.Load())
+
+		// failure
+		r, ch, _ = newRouter(t)
+		go func() { ch <- netmap.NetMap{} }()
+		_, err = r.currentNetMap(t.Context())
+		require.EqualError(t, err, "get network map: any error")
+	})
+
+	t.Run("outdated", func(t *testing.T) {
+		r, ch, requests := newRouter(t)
+
+		var nm netmap.NetMap
+		nm.SetEpoch(13)
+		go func() { ch <- nm }()
+		_, err := r.currentNetMap(t.Context())
+		require.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		// slow refresh does not block requests
+		for range 10 {
+			res, err := r.currentNetMap(t.Context())
+			require.NoError(t, err)
+			require.EqualValues(t, 13, res.Epoch())
+		}
+		require.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, time.Millisecond)
+		_, err = r.currentNetMap(t.Context())
+		require.NoError(t, err)
+		require.EqualValues(t, 2, requests.Load())
+
+		// failed refresh keeps outdated network map
+		ch <- netmap.NetMap{}
+		require.Eventually(t, func() bool {
+			res, err := r.currentNetMap(t.Context())
+			return err == nil && res.Epoch() == 13 && requests.Load() == 3
+		}, time.Second, time.Millisecond)
+
+		nm.SetEpoch(14)
+		ch <- nm
+		require.Eventually(t, func() bool {
+			res, err := r.currentNetMap(t.Context())
+			return err == nil && res.Epoch() == 14
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestPlacementRouter_containerNodes(t *testing.T) {
+	var nm netmap.NetMap
+	nm.SetEpoch(13)
+	cnr := cidtest.ID()
+
+	r, err := newPlacementRouter(50*time.Millisecond, time.Minute, nil)
+	require.NoError(t, err)
+	var gets atomic.Int32
+	r.getContainer = func(context.Context, cid.ID) (container.Container, error) {
+		gets.Add(1)
+		return container.Container{}, apistatus.ErrContainerNotFound
+	}
+
+	for range 10 {
+		_, err = r.containerNodes(t.Context(), &nm, cnr)
+		require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+	}
+	require.EqualValues(t, 1, gets.Load())
+
+	time.Sleep(50 * time.Millisecond)
+	_, err = r.containerNodes(t.Context(), &nm, cnr)
+	require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+	require.EqualValues(t, 2, gets.Load())
+
+	t.Run("cancelled request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		r.getContainer = func(ctx context.Context, _ cid.ID) (container.Container, error) {
+			gets.Add(1)
+			return container.Container{}, ctx.Err()
+		}
+		other := cidtest.ID()
+		for range 2 {
+			_, err = r.containerNodes(ctx, &nm, other)
+			require.ErrorIs(t, err, context.Canceled)
+		}
+		require.EqualValues(t, 4, gets.Load())
+	})
+}
+
+func nodeEndpointKey(t testing.TB, n netmap.NodeInfo) string {
+	for ep := range n.NetworkEndpoints() {
+		res, ok := endpointKey(ep)
+		require.True(t, ok)
+		return res
+	}
+	t.Fatal("node has no endpoints")
+	return ""
+}
+
+func TestPool_PlacementRouting(t *testing.T) {
+	const nodeNum = 4
+
+	var nm netmap.NetMap
+	nm.SetEpoch(13)
+	nodes := make([]netmap.NodeInfo, nodeNum)
+	for i := range nodes {
+		nodes[i].SetPublicKey(neofscryptotest.Signer().PublicKeyBytes)
+		nodes[i].SetNetworkEndpoints(fmt.Sprintf("/dns4/peer%d/tcp/8080", i))
+	}
+	nm.SetNodes(nodes)
+
+	var policy netmap.PlacementPolicy
+	require.NoError(t, policy.DecodeString("REP 1"))
+	var cnr container.Container
+	cnr.SetPlacementPolicy(policy)
+	cnrID := cidtest.ID()
+
+	newPool := func(t *testing.T, nm *netmap.NetMap, opts InitParameters) (*Pool, map[string]*mockClient, *atomic.Int32) {
+		var containerGets atomic.Int32
+		clients := make(map[string]*mockClient)
+		opts.setClientBuilder(func(addr string) (internalClient, error) {
+			c := newMockClient(addr, neofscryptotest.Signer())
+			c.netMap = nm
+			c.container = &cnr
+			c.containerGets = &containerGets
+			clients[addr] = c
+			return c, nil
+		})
+		opts.signer = usertest.User().RFC6979
+		for i := range nodeNum {
+			opts.AddNode(NewNodeParam(1, anyValidPeerAddress(uint(i)), 1))
+		}
+		opts.EnablePlacementRouting()
+
+		p, err := NewPool(opts)
+		require.NoError(t, err)
+		require.NoError(t, p.Dial(t.Context()))
+		t.Cleanup(func() { _ = p.Close })
+		return p, clients, &containerGets
+	}
+
+	cnrNodes, err := nm.ContainerNodes(policy, cnrID)
+	require.NoError(t, err)
+
+	t.Run("primary nodes", func(t *testing.T) {
+		p, _, containerGets := newPool(t, &nm, InitParameters{})
+
+		for _, id := range oidtest.IDs(50) {
+			vs, err := nm.PlacementVectors(cnrNodes, id)
+			require.NoError(t, err)
+
+			c, err := p.objectClient(t.Context(), cnrID, id)
+			require.NoError(t, err)
+			require.Equal(t, nodeEndpointKey(t, vs[0][0]), c.addr)
+		}
+		require.EqualValues(t, 1, containerGets.Load())
+	})
+
+	t.Run("unhealthy primary node", func(t *testing.T) {
+		p, clients, _ := newPool(t, &nm, InitParameters{})
+
+		for _, id := range oidtest.IDs(20) {
+			vs, err := nm.PlacementVectors(cnrNodes, id)
+			require.NoError(t, err)
+
+			primary := nodeEndpointKey(t, vs[0][0])
+			secondary := nodeEndpointKey(t, vs[0][1])
+
+			clients[primary].setUnhealthy()
+			c, err := p.objectClient(t.Context(), cnrID, id)
+			require.NoError(t, err)
+			require.Equal(t, secondary, c.addr)
+			clients[primary].setHealthy()
+		}
+	})
+
+	t.Run("excluded primary node", func(t *testing.T) {
+		p, _, _ := newPool(t, &nm, InitParameters{})
+
+		for _, id := range oidtest.IDs(20) {
+			vs, err := nm.PlacementVectors(cnrNodes, id)
+			require.NoError(t, err)
+
+			primary := nodeEndpointKey(t, vs[0][0])
+			secondary := nodeEndpointKey(t, vs[0][1])
+
+			c, err := p.objectClient(t.Context(), cnrID, id, primary)
+			require.NoError(t, err)
+			require.Equal(t, secondary, c.addr)
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetRetryPolicy(NewRetryPolicy(2, 0))
+		p, clients, _ := newPool(t, &nm, opts)
+
+		for _, id := range oidtest.IDs(8) {
+			vs, err := nm.PlacementVectors(cnrNodes, id)
+			require.NoError(t, err)
+
+			primary := nodeEndpointKey(t, vs[0][0])
+			secondary := nodeEndpointKey(t, vs[0][1])
+
+			clients[primary].errOnHeadObject = apistatus.ErrServerInternal
+			hdr, err := p.ObjectHead(t.Context(), cnrID, id, usertest.User(), client.PrmObjectHead{})
+			clients[primary].errOnHeadObject = nil
+			require.NoError(t, err)
+			require.Equal(t, secondary, hdr.Attributes()[0].Value())
+		}
+	})
+
+	t.Run("no placement nodes in pool", func(t *testing.T) {
+		var other netmap.NetMap
+		otherNodes := make([]netmap.NodeInfo, nodeNum)
+		for i := range otherNodes {
+			otherNodes[i].SetPublicKey(neofscryptotest.Signer().PublicKeyBytes)
+			otherNodes[i].SetNetworkEndpoints(fmt.Sprintf("/dns4/other%d/tcp/8080", i))
+		}
+		other.SetNodes(otherNodes)
+
+		p, _, _ := newPool(t, &other, InitParameters{})
+
+		c, err := p.objectClient(t.Context(), cnrID, oidtest.ID())
+		require.NoError(t, err)
+		require.Contains(t, []string{anyValidPeerAddress(0), anyValidPeerAddress(1), anyValidPeerAddress(2), anyValidPeerAddress(3)}, c.addr)
+	})
+
+	t.Run("no network map", func(t *testing.T) {
+		p, _, containerGets := newPool(t, nil, InitParameters{})
+
+		c, err := p.objectClient(t.Context(), cnrID, oidtest.ID())
+		require.NoError(t, err)
+		require.NotEmpty(t, c.addr)
+		require.Zero(t, containerGets.Load())
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		var opts InitParameters
+		opts.SetNetMapRefreshInterval(time.Millisecond)
+		p, clients, _ := newPool(t, &nm, opts)
+
+		id := oidtest.ID()
+		vs, err := nm.PlacementVectors(cnrNodes, id)
+		require.NoError(t, err)
+		exp := nodeEndpointKey(t, vs[0][0])
+
+		c, err := p.objectClient(t.Context(), cnrID, id)
+		require.NoError(t, err)
+		require.Equal(t, exp, c.addr)
+
+		// network map becomes unavailable, cached one is used
+		for _, cl := range clients {
+			cl.netMap = nil
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		c, err = p.objectClient(t.Context(), cnrID, id)
+		require.NoError(t, err)
+		require.Equal(t, exp, c.addr)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		p, err := NewPool(InitParameters{
+			signer:     usertest.User().RFC6979,
+			nodeParams: []NodeParam{NewNodeParam(1, anyValidPeerAddress(0), 1)},
+		})
+		require.NoError(t, err)
+		require.Nil(t, p.placement)
+	})
+}
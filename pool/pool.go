@@ -6,18 +6,21 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/nspcc-dev/neofs-sdk-go/accounting"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	sdkClient "github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/telemetry"
 	"github.com/nspcc-dev/neofs-sdk-go/internal/uriutil"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -25,7 +28,10 @@ import (
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -57,6 +63,7 @@ type sdkClientInterface interface {
 	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm sdkClient.PrmObjectHead) (*object.Object, error)
 	ObjectRangeInit(ctx context.Context, containerID cid.ID, objectID oid.ID, offset, length uint64, signer user.Signer, prm sdkClient.PrmObjectRange) (*sdkClient.ObjectRangeReader, error)
 	ObjectDelete(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm sdkClient.PrmObjectDelete) (oid.ID, error)
+	ObjectHash(ctx context.Context, containerID cid.ID, objectID oid.ID, ranges []object.Range, typ checksum.Type, salt []byte, signer user.Signer, prm sdkClient.PrmObjectHash) ([]checksum.Checksum, error)
 	ObjectSearchInit(ctx context.Context, containerID cid.ID, signer user.Signer, prm sdkClient.PrmObjectSearch) (*sdkClient.ObjectListReader, error)
 	SearchObjects(ctx context.Context, containerID cid.ID, filters object.SearchFilters, attrs []string, cursor string, signer neofscrypto.Signer, opts sdkClient.SearchObjectsOptions) ([]sdkClient.SearchResultItem, string, error)
 
@@ -176,15 +183,20 @@ type wrapperPrm struct {
 	errorThreshold           uint32
 	errorThresholdWindowSize time.Duration
 	statisticCallback        stat.OperationCallback
+	payloadCallback          stat.PayloadCallback
 	buffers                  *sync.Pool
 	nodeSessionCacheSize     int
+	dialFunc                 func(context.Context, string) (net.Conn, error)
+	tracerProvider           trace.TracerProvider
 }
 
 // getNewClient returns a new [sdkClient.Client] instance using internal parameters.
 func (x *wrapperPrm) getNewClient(statisticCallback stat.OperationCallback) (*sdkClient.Client, error) {
 	var prmInit sdkClient.PrmInit
 	prmInit.SetStatisticCallback(statisticCallback)
+	prmInit.SetPayloadCallback(x.payloadCallback)
 	prmInit.SetSignMessageBuffers(x.buffers)
+	prmInit.SetTracerProvider(x.tracerProvider)
 
 	return sdkClient.New(prmInit)
 }
@@ -247,6 +259,9 @@ func (c *clientWrapper) dial(ctx context.Context) error {
 	prmDial.SetTimeout(c.prm.dialTimeout)
 	prmDial.SetStreamTimeout(c.prm.streamTimeout)
 	prmDial.SetContext(ctx)
+	if c.prm.dialFunc != nil {
+		prmDial.SetDialFunc(c.prm.dialFunc)
+	}
 
 	if err = cl.Dial(prmDial); err != nil {
 		c.setUnhealthy()
@@ -274,6 +289,9 @@ func (c *clientWrapper) restartIfUnhealthy(ctx context.Context) (healthy, change
 		prmDial.SetTimeout(c.prm.dialTimeout)
 		prmDial.SetStreamTimeout(c.prm.streamTimeout)
 		prmDial.SetContext(ctx)
+		if c.prm.dialFunc != nil {
+			prmDial.SetDialFunc(c.prm.dialFunc)
+		}
 
 		if err := cl.Dial(prmDial); err != nil {
 			c.setUnhealthy()
@@ -360,7 +378,8 @@ func (c *clientStatusMonitor) updateErrorRate(err error) {
 }
 
 func isHealthCountedError(err error) bool {
-	if err == nil {
+	// cancelled gRPC calls, e.g. hedged requests, return status errors
+	if err == nil || errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled {
 		return false
 	}
 
@@ -402,10 +421,22 @@ type InitParameters struct {
 	nodeSessionCacheSize       int
 	useV2Sessions              bool
 	disableSessionV2Delegation bool
+	placementRouting           bool
+	netMapRefreshInterval      time.Duration
+	retryPolicy                RetryPolicy
+	hedgingDelayFunc           func(stat.Method) time.Duration
+	dialFunc                   func(context.Context, string) (net.Conn, error)
+	tracerProvider             trace.TracerProvider
+	maxInFlight                int
+	maxInFlightPerNode         int
+	rateLimits                 [operationClassLast]rateLimit
 
 	clientBuilder clientBuilder
 
 	statisticCallback stat.OperationCallback
+	payloadCallback   stat.PayloadCallback
+	healthCallback    stat.HealthCallback
+	limitCallback     stat.LimitCallback
 }
 
 // SetSigner specifies default signer to be used for the protocol communication by default.
@@ -463,6 +494,13 @@ func (x *InitParameters) SetErrorThresholdWindowSize(window time.Duration) {
 	x.errorThresholdWindowSize = window
 }
 
+// SetDialFunc overrides default network dialer used to connect to the nodes,
+// e.g. to connect to the in-memory listeners in tests. See
+// [sdkClient.PrmDial.SetDialFunc] for details. Nil f restores the default.
+func (x *InitParameters) SetDialFunc(f func(ctx context.Context, addr string) (net.Conn, error)) {
+	x.dialFunc = f
+}
+
 // AddNode append information about the node to which you want to connect.
 func (x *InitParameters) AddNode(nodeParam NodeParam) {
 	x.nodeParams = append(x.nodeParams, nodeParam)
@@ -484,6 +522,71 @@ func (x *InitParameters) SetStatisticCallback(statisticCallback stat.OperationCa
 	x.statisticCallback = statisticCallback
 }
 
+// SetPayloadCallback makes the Pool to pass [stat.PayloadCallback] for external
+// statistic of the object payload bytes transmitted by the nodes. See
+// [sdkClient.PrmInit.SetPayloadCallback] for details.
+func (x *InitParameters) SetPayloadCallback(payloadCallback stat.PayloadCallback) {
+	x.payloadCallback = payloadCallback
+}
+
+// SetHealthCallback makes the Pool to pass [stat.HealthCallback] for external
+// monitoring of the node health states. The callback is called for each node
+// on Dial and on each health check, see [InitParameters.SetClientRebalanceInterval].
+func (x *InitParameters) SetHealthCallback(healthCallback stat.HealthCallback) {
+	x.healthCallback = healthCallback
+}
+
+// SetLimitCallback makes the Pool to pass [stat.LimitCallback] for external
+// statistic of the requests delayed by the Pool limits.
+//
+// See also [InitParameters.SetMaxInFlight], [InitParameters.SetMaxInFlightPerNode],
+// [InitParameters.SetRateLimit].
+func (x *InitParameters) SetLimitCallback(limitCallback stat.LimitCallback) {
+	x.limitCallback = limitCallback
+}
+
+// SetMaxInFlight limits number of requests concurrently executed by the Pool on
+// all nodes. Requests exceeding the limit wait for the completed ones until
+// their context is done. Object writing stream occupies the slot until it is
+// closed, reading streams do until they are opened only. Non-positive value
+// means no limit, which is the default.
+//
+// Requests made via [Pool.RawClient] and health checks are not limited.
+func (x *InitParameters) SetMaxInFlight(n int) {
+	x.maxInFlight = n
+}
+
+// SetMaxInFlightPerNode limits number of requests concurrently executed by the
+// Pool on each node. The limit applies to the node selected for the request,
+// other nodes are not tried. Non-positive value means no limit, which is the
+// default. See [InitParameters.SetMaxInFlight] for details.
+func (x *InitParameters) SetMaxInFlightPerNode(n int) {
+	x.maxInFlightPerNode = n
+}
+
+// SetRateLimit limits rate of the Pool operations of the given class to r
+// requests per second with bursts of at most burst requests. Each attempt of
+// the retried or hedged operation is counted. Requests exceeding the limit
+// wait until it allows them or their context is done. Non-positive r means no
+// limit, which is the default. SetRateLimit panics if class is unknown.
+func (x *InitParameters) SetRateLimit(class OperationClass, r float64, burst int) {
+	if class >= operationClassLast {
+		panic(fmt.Sprintf("unknown operation class %d", class))
+	}
+	x.rateLimits[class] = rateLimit{rate: r, burst: burst}
+}
+
+// SetTracerProvider makes the Pool to trace its operations via OpenTelemetry
+// spans created by the given provider. Span is created for each Pool operation,
+// span names are [stat.Method] strings prefixed with "pool.". Spans of the
+// underlying client operations, including retries and hedged requests, are
+// nested into them, see [sdkClient.PrmInit.SetTracerProvider] for details.
+//
+// Tracing is disabled by default.
+func (x *InitParameters) SetTracerProvider(tp trace.TracerProvider) {
+	x.tracerProvider = tp
+}
+
 // SetNodeSessionCacheSize sets cache size for the basic sessions for node.
 func (x *InitParameters) SetNodeSessionCacheSize(cacheSize int) {
 	x.nodeSessionCacheSize = cacheSize
@@ -499,6 +602,74 @@ func (x *InitParameters) DisableSessionV2Delegation() {
 	x.disableSessionV2Delegation = true
 }
 
+// EnablePlacementRouting makes the Pool to send object GET, HEAD, RANGE and
+// HASH requests to the nodes which should store the object according to the
+// container placement policy. To do this, the Pool caches the current network
+// map and placement policies of the requested containers. If none of the
+// nodes storing the object is in the Pool or healthy, the request is sent to
+// the connection selected in the regular way.
+//
+// Note that network endpoints announced by the storage nodes must match the
+// addresses passed to the Pool.
+//
+// See also [InitParameters.SetNetMapRefreshInterval].
+func (x *InitParameters) EnablePlacementRouting() {
+	x.placementRouting = true
+}
+
+// SetNetMapRefreshInterval specifies how often the Pool refreshes the network
+// map cached for placement routing. Defaults to 1 minute. Outdated network map
+// is used while the refresh is in progress or if it fails. Refresh request is
+// limited by [InitParameters.SetHealthcheckTimeout]. Failed requests of the
+// container placement policy are not repeated for the same interval.
+//
+// See also [InitParameters.EnablePlacementRouting].
+func (x *InitParameters) SetNetMapRefreshInterval(interval time.Duration) {
+	x.netMapRefreshInterval = interval
+}
+
+// SetRetryPolicy makes the Pool to retry idempotent operations failed on one
+// node on other nodes according to the given policy. The operations are:
+//   - [Pool.BalanceGet];
+//   - [Pool.ContainerGet], [Pool.ContainerList] and [Pool.ContainerEACL];
+//   - [Pool.NetworkInfo] and [Pool.NetMapSnapshot];
+//   - [Pool.ObjectHead], [Pool.ObjectHash] and [Pool.SearchObjects];
+//   - [Pool.ObjectGetInit] (only the header request, payload reading is not
+//     retried).
+//
+// By default, operations are not retried.
+func (x *InitParameters) SetRetryPolicy(p RetryPolicy) {
+	x.retryPolicy = p
+}
+
+// SetHedgingDelay enables hedged reads: if [Pool.ObjectHead] or
+// [Pool.ObjectGetInit] request is not completed within the specified delay,
+// the same request is sent to another healthy node, and the first successful
+// response is used while the other request is cancelled. Both requests are
+// reported to the [stat.OperationCallback]. Non-positive delay disables
+// hedging, which is the default.
+//
+// Hedging increases the load on the storage nodes, so the delay should be
+// close to the high percentile of the request duration.
+//
+// See also [InitParameters.SetHedgingDelayFunc].
+func (x *InitParameters) SetHedgingDelay(d time.Duration) {
+	if d <= 0 {
+		x.hedgingDelayFunc = nil
+		return
+	}
+	x.hedgingDelayFunc = func(stat.Method) time.Duration { return d }
+}
+
+// SetHedgingDelayFunc is an alternative to [InitParameters.SetHedgingDelay]
+// allowing to calculate the delay dynamically for each request, e.g. from the
+// timings collected by [stat.PoolStat]. Function receives [stat.MethodObjectHead]
+// or [stat.MethodObjectGet], non-positive result disables hedging for the
+// particular request. Nil f disables hedging.
+func (x *InitParameters) SetHedgingDelayFunc(f func(stat.Method) time.Duration) {
+	x.hedgingDelayFunc = f
+}
+
 type rebalanceParameters struct {
 	nodesParams               []*nodesParam
 	nodeRequestTimeout        time.Duration
@@ -585,8 +756,20 @@ type Pool struct {
 	logger                   *zap.Logger
 
 	statisticCallback stat.OperationCallback
+	healthCallback    stat.HealthCallback
+	tracer            telemetry.Tracer
+
+	// nil if there are no limits.
+	limiter *limiter
 
 	buffers *sync.Pool
+
+	retryPolicy RetryPolicy
+	// nil if hedging is disabled.
+	hedgingDelayFunc func(stat.Method) time.Duration
+
+	// nil if placement routing is disabled.
+	placement *placementRouter
 }
 
 type innerPool struct {
@@ -670,6 +853,7 @@ func NewFlatNodeParams(endpoints []string) []NodeParam {
 // - HealthcheckTimeout: 4, in seconds
 // - NodeDialTimeout: 5, in seconds
 // - NodeStreamTimeout: 10, in seconds
+// - NetMapRefreshInterval: 60, in seconds
 //
 // Notice that error threshold settings are ignored if there is just one node
 // configured for this pool.
@@ -724,6 +908,24 @@ func NewPool(options InitParameters) (*Pool, error) {
 	}
 	pool.clientBuilder = options.clientBuilder
 	pool.statisticCallback = options.statisticCallback
+	pool.healthCallback = options.healthCallback
+	pool.limiter = newLimiter(options)
+	pool.tracer = telemetry.NewTracer(options.tracerProvider, "github.com/nspcc-dev/neofs-sdk-go/pool", "pool.", trace.SpanKindInternal)
+	pool.retryPolicy = options.retryPolicy
+	pool.hedgingDelayFunc = options.hedgingDelayFunc
+
+	if options.placementRouting {
+		pool.placement, err = newPlacementRouter(options.netMapRefreshInterval, options.healthcheckTimeout, options.logger)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create placement router: %w", err)
+		}
+		pool.placement.getNetMap = func(ctx context.Context) (netmap.NetMap, error) {
+			return pool.NetMapSnapshot(ctx, sdkClient.PrmNetMapSnapshot{})
+		}
+		pool.placement.getContainer = func(ctx context.Context, id cid.ID) (container.Container, error) {
+			return pool.ContainerGet(ctx, id, sdkClient.PrmContainerGet{})
+		}
+	}
 
 	return pool, nil
 }
@@ -757,11 +959,20 @@ func (p *Pool) Dial(ctx context.Context) error {
 				if p.logger != nil {
 					p.logger.Warn("failed to dial client", zap.String("address", addr), zap.Error(err))
 				}
+				p.healthMiddleware(addr, false)
 				continue
 			}
+			p.healthMiddleware(addr, true)
 
 			atLeastOneHealthy = true
 		}
+		if p.placement != nil {
+			for _, c := range clients {
+				if c != nil {
+					p.placement.addClient(c)
+				}
+			}
+		}
 		sampl := newSampler(params.weights, safeRand{})
 
 		inner[i] = &innerPool{
@@ -826,8 +1037,11 @@ func fillDefaultInitParams(params *InitParameters, cache *sessionCache, statisti
 				errorThreshold:           params.errorThreshold,
 				errorThresholdWindowSize: params.errorThresholdWindowSize,
 				statisticCallback:        statisticCallback,
+				payloadCallback:          params.payloadCallback,
 				buffers:                  buffers,
 				nodeSessionCacheSize:     params.nodeSessionCacheSize,
+				dialFunc:                 params.dialFunc,
+				tracerProvider:           params.tracerProvider,
 			}
 			return newWrapper(prm)
 		})
@@ -914,6 +1128,7 @@ func (p *Pool) updateInnerNodesHealth(ctx context.Context, i int, bufferWeights
 			defer c()
 
 			healthy, changed := cli.restartIfUnhealthy(tctx)
+			p.healthMiddleware(cli.address(), healthy)
 			if healthy {
 				bufferWeights[j] = options.nodesParams[i].weights[j]
 			} else {
@@ -952,23 +1167,29 @@ func adjustWeights(weights []float64) []float64 {
 	return adjusted
 }
 
-func (p *Pool) connection() (internalClient, error) {
+// connection returns healthy connection from the Pool. Connections to the
+// excluded addresses are returned only if there are no other healthy ones.
+func (p *Pool) connection(exclude ...string) (internalClient, error) {
 	for _, inner := range p.innerPools {
-		cp, err := inner.connection()
+		cp, err := inner.connection(exclude)
 		if err == nil {
 			return cp, nil
 		}
 	}
 
+	if len(exclude) > 0 {
+		return p.connection()
+	}
+
 	return nil, ErrUnhealthy
 }
 
-func (p *innerPool) connection() (internalClient, error) {
+func (p *innerPool) connection(exclude []string) (internalClient, error) {
 	p.lock.RLock() // need lock because of using p.sampler
 	defer p.lock.RUnlock()
 	if len(p.clients) == 1 {
 		cp := p.clients[0]
-		if cp.isHealthy() {
+		if cp.isHealthy() && !slices.Contains(exclude, cp.address()) {
 			return cp, nil
 		}
 		return nil, ErrUnhealthy
@@ -976,10 +1197,18 @@ func (p *innerPool) connection() (internalClient, error) {
 	attempts := 3 * len(p.clients)
 	for range attempts {
 		i := p.sampler.next()
-		if cp := p.clients[i]; cp.isHealthy() {
+		if cp := p.clients[i]; cp.isHealthy() && !slices.Contains(exclude, cp.address()) {
 			return cp, nil
 		}
 	}
+	if len(exclude) > 0 {
+		// sampler is likely to return excluded clients, so check all of them
+		for _, cp := range p.clients {
+			if cp != nil && cp.isHealthy() && !slices.Contains(exclude, cp.address()) {
+				return cp, nil
+			}
+		}
+	}
 
 	return nil, ErrUnhealthy
 }
@@ -1037,8 +1266,8 @@ func (p *Pool) Close() error {
 	return errors.Join(es...)
 }
 
-func (p *Pool) sdkClient() (*sdkClientWrapper, error) {
-	conn, err := p.connection()
+func (p *Pool) sdkClient(exclude ...string) (*sdkClientWrapper, error) {
+	conn, err := p.connection(exclude...)
 	if err != nil {
 		return nil, fmt.Errorf("connection: %w", err)
 	}
@@ -1049,14 +1278,40 @@ func (p *Pool) sdkClient() (*sdkClientWrapper, error) {
 	}
 
 	return &sdkClientWrapper{
-		sdkClientInterface: cl,
+		sdkClientInterface: p.limit(cl, conn.address()),
 		nodeSession:        conn,
 		addr:               conn.address(),
 	}, nil
 }
 
+// objectClient returns client to execute request to the referenced object. If
+// placement routing is enabled, connections to the nodes storing the object are
+// preferred. Connections with the excluded addresses are used only if there
+// are no others.
+func (p *Pool) objectClient(ctx context.Context, cnr cid.ID, obj oid.ID, exclude ...string) (*sdkClientWrapper, error) {
+	if p.placement != nil {
+		if conn := p.placement.connection(ctx, cnr, obj, exclude...); conn != nil {
+			if cl, err := conn.getClient(); err == nil {
+				return &sdkClientWrapper{
+					sdkClientInterface: p.limit(cl, conn.address()),
+					nodeSession:        conn,
+					addr:               conn.address(),
+				}, nil
+			}
+		}
+	}
+
+	return p.sdkClient(exclude...)
+}
+
 func (p *Pool) statisticMiddleware(nodeKey []byte, endpoint string, method stat.Method, duration time.Duration, err error) {
 	if p.statisticCallback != nil {
 		p.statisticCallback(nodeKey, endpoint, method, duration, err)
 	}
 }
+
+func (p *Pool) healthMiddleware(endpoint string, healthy bool) {
+	if p.healthCallback != nil {
+		p.healthCallback(endpoint, healthy)
+	}
+}
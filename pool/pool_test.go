@@ -11,6 +11,7 @@ import (
 	"io"
 	"slices"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -24,10 +25,13 @@ import (
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	sessionv2 "github.com/nspcc-dev/neofs-sdk-go/session/v2"
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
 	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type noopNNSResolver struct{}
@@ -743,6 +747,21 @@ func TestHandleError(t *testing.T) {
 			expectedError: true,
 			countError:    true,
 		},
+		{
+			err:           context.Canceled,
+			expectedError: true,
+			countError:    false,
+		},
+		{
+			err:           fmt.Errorf("rpc failure: %w", status.Error(codes.Canceled, "context canceled")),
+			expectedError: true,
+			countError:    false,
+		},
+		{
+			err:           status.Error(codes.Unavailable, "connection refused"),
+			expectedError: true,
+			countError:    true,
+		},
 	} {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			errCount := monitor.errThr.Current()
@@ -1025,3 +1044,48 @@ func TestSessionTokenV2Delegation(t *testing.T) {
 		require.False(t, ok, "delegated token should be removed from cache on SessionTokenNotFound")
 	})
 }
+
+func TestPool_HealthCallback(t *testing.T) {
+	nodes := []NodeParam{
+		{1, anyValidPeerAddress(0), 1},
+		{1, anyValidPeerAddress(1), 1},
+	}
+
+	var failed atomic.Bool
+	mockClientBuilder := func(addr string) (internalClient, error) {
+		mockCli := newMockClient(addr, neofscryptotest.Signer())
+		if addr == nodes[1].address {
+			mockCli.errOnDial()
+		}
+		return mockCli, nil
+	}
+
+	ps := stat.NewPoolStatistic()
+	opts := InitParameters{
+		signer:                  usertest.User().RFC6979,
+		nodeParams:              nodes,
+		clientRebalanceInterval: 50 * time.Millisecond,
+	}
+	opts.setClientBuilder(mockClientBuilder)
+	opts.SetHealthCallback(func(endpoint string, healthy bool) {
+		if !healthy {
+			failed.Store(true)
+		}
+		ps.HealthCallback(endpoint, healthy)
+	})
+
+	pool, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, pool.Dial(t.Context()))
+
+	expected := map[string]bool{
+		nodes[0].address: true,
+		nodes[1].address: false,
+	}
+	require.Equal(t, expected, ps.Statistic().Health())
+	require.True(t, failed.Load())
+
+	failed.Store(false)
+	require.Eventually(t, failed.Load, time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, ps.Statistic().Health())
+}
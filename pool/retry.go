@@ -0,0 +1,146 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy configures retries of idempotent [Pool] operations on other
+// connections. Zero RetryPolicy disables retries.
+//
+// RetryPolicy should be created using [NewRetryPolicy].
+type RetryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	retryable   func(error) bool
+}
+
+// NewRetryPolicy constructs new RetryPolicy executing operation maxAttempts
+// times at most including the first attempt. Delay before the second attempt
+// is backoff, and it is doubled for each subsequent attempt. By default,
+// [IsRetryableError] decides whether the operation should be retried.
+func NewRetryPolicy(maxAttempts int, backoff time.Duration) RetryPolicy {
+	return RetryPolicy{maxAttempts: maxAttempts, backoff: backoff}
+}
+
+// SetMaxBackoff limits the delay between attempts. Zero means no limit.
+func (x *RetryPolicy) SetMaxBackoff(d time.Duration) {
+	x.maxBackoff = d
+}
+
+// SetRetryableFunc sets function deciding whether the operation failed with
+// the given error should be retried. Nil f means [IsRetryableError].
+func (x *RetryPolicy) SetRetryableFunc(f func(error) bool) {
+	x.retryable = f
+}
+
+// MaxAttempts returns maximum number of attempts set using [NewRetryPolicy].
+func (x RetryPolicy) MaxAttempts() int {
+	return x.maxAttempts
+}
+
+// delay returns delay before the given attempt (starting from 1 for the
+// second one).
+func (x RetryPolicy) delay(attempt int) time.Duration {
+	d := x.backoff
+	for range attempt - 1 {
+		if x.maxBackoff > 0 && d >= x.maxBackoff {
+			break
+		}
+		d *= 2
+	}
+	if x.maxBackoff > 0 && d > x.maxBackoff {
+		d = x.maxBackoff
+	}
+	return d
+}
+
+func (x RetryPolicy) isRetryable(err error) bool {
+	if x.retryable != nil {
+		return x.retryable(err)
+	}
+	return IsRetryableError(err)
+}
+
+// IsRetryableError checks whether the operation failed with the given error
+// may succeed on another node. These are:
+//   - [apistatus.ErrServerInternal];
+//   - [apistatus.ErrNodeUnderMaintenance];
+//   - [ErrUnhealthy];
+//   - gRPC transport failures with [codes.Unavailable], [codes.ResourceExhausted]
+//     and [codes.Internal] status codes.
+//
+// Any other error, e.g. other NeoFS API statuses, local client errors or
+// context errors, is not retryable.
+func IsRetryableError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, apistatus.ErrServerInternal) || errors.Is(err, apistatus.ErrNodeUnderMaintenance) ||
+		errors.Is(err, ErrUnhealthy) || errors.Is(err, errPoolClientUnhealthy) {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// retry executes op on c and, if it fails with retryable error, re-executes it
+// on other connections selected by next according to the retry policy. Returns
+// the last error.
+func (p *Pool) retry(ctx context.Context, c *sdkClientWrapper, next func(exclude ...string) (*sdkClientWrapper, error), op func(*sdkClientWrapper) error) error {
+	err := op(c)
+	if err == nil || p.retryPolicy.maxAttempts <= 1 {
+		return err
+	}
+
+	last := c.addr
+	tried := []string{last}
+	for attempt := 1; attempt < p.retryPolicy.maxAttempts && p.retryPolicy.isRetryable(err); attempt++ {
+		if d := p.retryPolicy.delay(attempt); d > 0 {
+			t := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return err
+			case <-t.C:
+			}
+		} else if ctx.Err() != nil {
+			return err
+		}
+
+		nc, cErr := next(tried...)
+		if cErr != nil {
+			return err
+		}
+
+		if p.logger != nil {
+			p.logger.Debug("retrying operation on another node",
+				zap.String("failed", last), zap.String("next", nc.addr),
+				zap.Int("attempt", attempt+1), zap.Error(err))
+		}
+
+		if last = nc.addr; !slices.Contains(tried, last) {
+			tried = append(tried, last)
+		}
+
+		if err = op(nc); err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,184 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	neofscryptotest "github.com/nspcc-dev/neofs-sdk-go/crypto/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicy_delay(t *testing.T) {
+	p := NewRetryPolicy(10, time.Second)
+	require.Equal(t, 10, p.MaxAttempts())
+	require.Equal(t, time.Second, p.delay(1))
+	require.Equal(t, 2*time.Second, p.delay(2))
+	require.Equal(t, 8*time.Second, p.delay(4))
+
+	p.SetMaxBackoff(5 * time.Second)
+	require.Equal(t, time.Second, p.delay(1))
+	require.Equal(t, 4*time.Second, p.delay(3))
+	require.Equal(t, 5*time.Second, p.delay(4))
+	require.Equal(t, 5*time.Second, p.delay(100))
+
+	require.Zero(t, NewRetryPolicy(3, 0).delay(5))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	for _, tc := range []struct {
+		err error
+		ok  bool
+	}{
+		{err: nil},
+		{err: context.Canceled},
+		{err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded)},
+		{err: apistatus.ErrObjectNotFound},
+		{err: apistatus.ErrObjectAccessDenied},
+		{err: apistatus.ErrContainerNotFound},
+		{err: object.NewSplitInfoError(object.NewSplitInfo())},
+		{err: apistatus.ErrServerInternal, ok: true},
+		{err: fmt.Errorf("wrapped: %w", apistatus.ErrNodeUnderMaintenance), ok: true},
+		{err: ErrUnhealthy, ok: true},
+		{err: errPoolClientUnhealthy, ok: true},
+		{err: client.ErrMissingSigner},
+		{err: errors.New("any local error")},
+		{err: status.Error(codes.Canceled, "context canceled")},
+		{err: status.Error(codes.InvalidArgument, "invalid request")},
+		{err: status.Error(codes.Unimplemented, "unknown method")},
+		{err: status.Error(codes.Unavailable, "connection refused"), ok: true},
+		{err: fmt.Errorf("rpc failure: %w", status.Error(codes.Unavailable, "connection refused")), ok: true},
+		{err: status.Error(codes.ResourceExhausted, "message too large"), ok: true},
+		{err: status.Error(codes.Internal, "stream terminated"), ok: true},
+	} {
+		require.Equal(t, tc.ok, IsRetryableError(tc.err), tc.err)
+	}
+}
+
+func TestPool_Retry(t *testing.T) {
+	const nodeNum = 3
+
+	var nm netmap.NetMap
+	nm.SetEpoch(42)
+
+	newPool := func(t *testing.T, policy RetryPolicy) (*Pool, []*mockClient) {
+		var clients []*mockClient
+		opts := InitParameters{signer: usertest.User().RFC6979}
+		opts.setClientBuilder(func(addr string) (internalClient, error) {
+			c := newMockClient(addr, neofscryptotest.Signer())
+			clients = append(clients, c)
+			return c, nil
+		})
+		for i := range nodeNum {
+			opts.AddNode(NewNodeParam(1, anyValidPeerAddress(uint(i)), 1))
+		}
+		opts.SetRetryPolicy(policy)
+
+		p, err := NewPool(opts)
+		require.NoError(t, err)
+		require.NoError(t, p.Dial(t.Context()))
+		t.Cleanup(func() { _ = p.Close })
+		return p, clients
+	}
+
+	t.Run("failover", func(t *testing.T) {
+		p, clients := newPool(t, NewRetryPolicy(nodeNum, 0))
+		clients[1].netMap = &nm // only one node responds
+
+		for range 20 {
+			res, err := p.NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+			require.NoError(t, err)
+			require.EqualValues(t, 42, res.Epoch())
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		p, clients := newPool(t, RetryPolicy{})
+		clients[1].netMap = &nm
+
+		require.Eventually(t, func() bool {
+			_, err := p.NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+			return err != nil
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		var checks int
+		policy := NewRetryPolicy(nodeNum, time.Millisecond)
+		policy.SetRetryableFunc(func(err error) bool {
+			checks++
+			return IsRetryableError(err)
+		})
+		p, _ := newPool(t, policy)
+
+		_, err := p.NetMapSnapshot(t.Context(), client.PrmNetMapSnapshot{})
+		require.EqualError(t, err, "rpc error: code = Unavailable desc = network map is unavailable")
+		require.Equal(t, nodeNum-1, checks)
+	})
+
+	t.Run("non-retryable", func(t *testing.T) {
+		var checks int
+		policy := NewRetryPolicy(nodeNum, 0)
+		policy.SetRetryableFunc(func(err error) bool {
+			checks++
+			return IsRetryableError(err)
+		})
+		p, _ := newPool(t, policy)
+
+		_, err := p.ContainerGet(t.Context(), cidtest.ID(), client.PrmContainerGet{})
+		require.ErrorIs(t, err, apistatus.ErrContainerNotFound)
+		require.Equal(t, 1, checks)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		p, _ := newPool(t, NewRetryPolicy(nodeNum, time.Minute))
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		t.Cleanup(cancel)
+
+		start := time.Now()
+		_, err := p.NetMapSnapshot(ctx, client.PrmNetMapSnapshot{})
+		require.EqualError(t, err, "rpc error: code = Unavailable desc = network map is unavailable")
+		require.Less(t, time.Since(start), time.Minute)
+	})
+}
+
+func TestPool_connectionExclude(t *testing.T) {
+	opts := InitParameters{signer: usertest.User().RFC6979}
+	opts.setClientBuilder(func(addr string) (internalClient, error) {
+		return newMockClient(addr, neofscryptotest.Signer()), nil
+	})
+	opts.AddNode(NewNodeParam(1, anyValidPeerAddress(0), 1))
+	opts.AddNode(NewNodeParam(1, anyValidPeerAddress(1), 1))
+	opts.AddNode(NewNodeParam(2, anyValidPeerAddress(2), 1))
+
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(t.Context()))
+	t.Cleanup(func() { _ = p.Close })
+
+	for range 20 {
+		c, err := p.connection(anyValidPeerAddress(0))
+		require.NoError(t, err)
+		require.Equal(t, anyValidPeerAddress(1), c.address())
+
+		c, err = p.connection(anyValidPeerAddress(0), anyValidPeerAddress(1))
+		require.NoError(t, err)
+		require.Equal(t, anyValidPeerAddress(2), c.address())
+
+		// all excluded, any healthy is returned
+		c, err = p.connection(anyValidPeerAddress(0), anyValidPeerAddress(1), anyValidPeerAddress(2))
+		require.NoError(t, err)
+		require.NotEmpty(t, c.address())
+	}
+}
@@ -0,0 +1,60 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	"github.com/nspcc-dev/neofs-sdk-go/neofstest"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPool_Tracing(t *testing.T) {
+	network := neofstest.NewNetwork(2)
+	t.Cleanup(network.Stop)
+	sr := tracetest.NewSpanRecorder()
+	usr := usertest.User()
+
+	var opts InitParameters
+	opts.SetSigner(usr.RFC6979)
+	opts.SetDialFunc(network.Dial)
+	opts.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	opts.SetRetryPolicy(NewRetryPolicy(2, 0))
+	for _, endpoint := range network.Endpoints() {
+		opts.AddNode(NewNodeParam(1, endpoint, 1))
+	}
+	p, err := NewPool(opts)
+	require.NoError(t, err)
+	require.NoError(t, p.Dial(t.Context()))
+	t.Cleanup(func() { _ = p.Close() })
+
+	nodes := network.Nodes()
+	nodes[0].SetDown(true)
+	for range 5 {
+		_, err = p.NetworkInfo(t.Context(), client.PrmNetworkInfo{})
+		require.NoError(t, err)
+	}
+
+	spans := sr.Ended()
+	var poolSpans int
+	for _, s := range spans {
+		if s.Name() != "pool.networkInfo" {
+			continue
+		}
+		poolSpans++
+		require.Equal(t, trace.SpanKindInternal, s.SpanKind())
+
+		var children int
+		for _, c := range spans {
+			if c.Parent().SpanID() == s.SpanContext().SpanID() {
+				require.Equal(t, "client.networkInfo", c.Name())
+				children++
+			}
+		}
+		require.NotZero(t, children)
+	}
+	require.Equal(t, 5, poolSpans)
+}
@@ -0,0 +1,57 @@
+package stat
+
+import (
+	"sync/atomic"
+)
+
+// CacheStat is an external statistic for client-side caches.
+type CacheStat struct {
+	hits   [MethodLast]atomic.Uint64
+	misses [MethodLast]atomic.Uint64
+}
+
+// NewCacheStatistic is a constructor for [CacheStat].
+func NewCacheStatistic() *CacheStat {
+	return new(CacheStat)
+}
+
+// CacheCallback implements [stat.CacheCallback].
+func (s *CacheStat) CacheCallback(method Method, hit bool) {
+	if !IsMethodValid(method) {
+		return
+	}
+
+	if hit {
+		s.hits[method].Add(1)
+	} else {
+		s.misses[method].Add(1)
+	}
+}
+
+// Hits returns number of method results served from the cache. Returns 0 for
+// invalid method.
+func (s *CacheStat) Hits(method Method) uint64 {
+	if !IsMethodValid(method) {
+		return 0
+	}
+	return s.hits[method].Load()
+}
+
+// Misses returns number of method calls not served from the cache. Returns 0
+// for invalid method.
+func (s *CacheStat) Misses(method Method) uint64 {
+	if !IsMethodValid(method) {
+		return 0
+	}
+	return s.misses[method].Load()
+}
+
+// HitRatio returns fraction of method calls served from the cache. Returns 0
+// if there were no calls or method is invalid.
+func (s *CacheStat) HitRatio(method Method) float64 {
+	hits, misses := s.Hits(method), s.Misses(method)
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
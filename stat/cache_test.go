@@ -0,0 +1,42 @@
+package stat
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheStat(t *testing.T) {
+	s := NewCacheStatistic()
+	require.Zero(t, s.HitRatio(MethodObjectHead))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				s.CacheCallback(MethodObjectHead, true)
+				s.CacheCallback(MethodObjectHead, true)
+				s.CacheCallback(MethodObjectHead, false)
+				s.CacheCallback(MethodContainerGet, false)
+			}
+		})
+	}
+	wg.Wait()
+
+	require.EqualValues(t, 2000, s.Hits(MethodObjectHead))
+	require.EqualValues(t, 1000, s.Misses(MethodObjectHead))
+	require.InDelta(t, 2./3, s.HitRatio(MethodObjectHead), 1e-9)
+	require.Zero(t, s.Hits(MethodContainerGet))
+	require.EqualValues(t, 1000, s.Misses(MethodContainerGet))
+	require.Zero(t, s.HitRatio(MethodContainerGet))
+
+	t.Run("invalid method", func(t *testing.T) {
+		for _, m := range []Method{-1, MethodLast} {
+			s.CacheCallback(m, true)
+			require.Zero(t, s.Hits(m))
+			require.Zero(t, s.Misses(m))
+			require.Zero(t, s.HitRatio(m))
+		}
+	})
+}
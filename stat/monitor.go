@@ -1,33 +1,85 @@
 package stat
 
 import (
+	"slices"
 	"sync/atomic"
 	"time"
 )
 
+// latencyBuckets are upper bounds of the request latency histogram buckets.
+var latencyBuckets = [...]time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyBuckets returns upper bounds of the request latency histogram buckets
+// in ascending order. See [Snapshot.LatencyHistogram].
+func LatencyBuckets() []time.Duration {
+	return slices.Clone(latencyBuckets[:])
+}
+
 // methodStatus provide statistic for specific method.
 type methodStatus struct {
 	name        string
 	allTime     atomic.Uint64
 	allRequests atomic.Uint64
+	errors      atomic.Uint64
+	payload     atomic.Uint64
+	latency     [len(latencyBuckets) + 1]atomic.Uint64
 }
 
 func (m *methodStatus) snapshot() Snapshot {
-	return Snapshot{
+	res := Snapshot{
 		allTime:     m.allTime.Load(),
 		allRequests: m.allRequests.Load(), // Technically racy wrt allTime, practically should be good enough.
+		errors:      m.errors.Load(),
+		payload:     m.payload.Load(),
+		latency:     make([]uint64, len(m.latency)),
+	}
+	for i := range m.latency {
+		res.latency[i] = m.latency[i].Load()
+	}
+	return res
+}
+
+// reset zeroes all counters returning their previous values.
+func (m *methodStatus) reset() Snapshot {
+	res := Snapshot{
+		allTime:     m.allTime.Swap(0),
+		allRequests: m.allRequests.Swap(0), // Same as for snapshot.
+		errors:      m.errors.Swap(0),
+		payload:     m.payload.Swap(0),
+		latency:     make([]uint64, len(m.latency)),
+	}
+	for i := range m.latency {
+		res.latency[i] = m.latency[i].Swap(0)
 	}
+	return res
 }
 
 func (m *methodStatus) incRequests(elapsed time.Duration) {
 	m.allRequests.Add(1)
 	m.allTime.Add(uint64(elapsed))
+	i, _ := slices.BinarySearch(latencyBuckets[:], elapsed)
+	m.latency[i].Add(1)
 }
 
 // Snapshot represents statistic for specific method.
 type Snapshot struct {
 	allTime     uint64
 	allRequests uint64
+	errors      uint64
+	payload     uint64
+	latency     []uint64
 }
 
 // AllTime returns sum of time, spent to specific request. Use with [time.Duration] to get human-readable value.
@@ -40,6 +92,135 @@ func (s Snapshot) AllRequests() uint64 {
 	return s.allRequests
 }
 
+// Errors returns amount of failed requests to node.
+func (s Snapshot) Errors() uint64 {
+	return s.errors
+}
+
+// LatencyHistogram returns numbers of requests by duration. Element i is the
+// number of requests that took more than the previous [LatencyBuckets]
+// element and not more than the i-th one. The last element is the number of
+// requests exceeding all buckets.
+//
+// The value returned shares memory with the structure itself, so changing it can lead to data corruption.
+// Make a copy if you need to change it.
+func (s Snapshot) LatencyHistogram() []uint64 {
+	return s.latency
+}
+
+// Percentile returns estimated duration which the given fraction (from 0 to
+// 1) of requests did not exceed. The value is linearly interpolated within the
+// [LatencyBuckets] element containing it, requests exceeding all buckets are
+// considered to last as long as the last one. Returns zero if there were no
+// requests.
+func (s Snapshot) Percentile(q float64) time.Duration {
+	var total uint64
+	for i := range s.latency {
+		total += s.latency[i]
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := min(max(q, 0), 1) * float64(total)
+	var cum uint64
+	for i, n := range s.latency {
+		if n == 0 || float64(cum+n) < rank {
+			cum += n
+			continue
+		}
+		if i == len(latencyBuckets) {
+			break
+		}
+		var lower time.Duration
+		if i > 0 {
+			lower = latencyBuckets[i-1]
+		}
+		return lower + time.Duration(float64(latencyBuckets[i]-lower)*(rank-float64(cum))/float64(n))
+	}
+	return latencyBuckets[len(latencyBuckets)-1]
+}
+
+// P50 returns estimated median request duration. See [Snapshot.Percentile].
+func (s Snapshot) P50() time.Duration {
+	return s.Percentile(0.5)
+}
+
+// P90 returns estimated 90th percentile of request durations. See
+// [Snapshot.Percentile].
+func (s Snapshot) P90() time.Duration {
+	return s.Percentile(0.9)
+}
+
+// P99 returns estimated 99th percentile of request durations. See
+// [Snapshot.Percentile].
+func (s Snapshot) P99() time.Duration {
+	return s.Percentile(0.99)
+}
+
+// Payload returns amount of object payload bytes transmitted by requests. It
+// is collected for object streams only: [MethodObjectGetStream],
+// [MethodObjectRangeStream] and [MethodObjectPutStream].
+func (s Snapshot) Payload() uint64 {
+	return s.payload
+}
+
+// Throughput returns average payload transmission rate in bytes per second of
+// the request time. See [Snapshot.Payload].
+func (s Snapshot) Throughput() float64 {
+	if s.allTime == 0 {
+		return 0
+	}
+	return float64(s.payload) / time.Duration(s.allTime).Seconds()
+}
+
+// limitStatus provide limiter statistic for specific method.
+type limitStatus struct {
+	delayed  atomic.Uint64
+	rejected atomic.Uint64
+	waitTime atomic.Uint64
+}
+
+func (m *limitStatus) snapshot() LimitSnapshot {
+	return LimitSnapshot{
+		delayed:  m.delayed.Load(),
+		rejected: m.rejected.Load(),
+		waitTime: m.waitTime.Load(),
+	}
+}
+
+func (m *limitStatus) reset() LimitSnapshot {
+	return LimitSnapshot{
+		delayed:  m.delayed.Swap(0),
+		rejected: m.rejected.Swap(0),
+		waitTime: m.waitTime.Swap(0),
+	}
+}
+
+// LimitSnapshot represents limiter statistic for specific method.
+type LimitSnapshot struct {
+	delayed  uint64
+	rejected uint64
+	waitTime uint64
+}
+
+// Delayed returns amount of requests delayed by the limits including rejected
+// ones.
+func (s LimitSnapshot) Delayed() uint64 {
+	return s.delayed
+}
+
+// Rejected returns amount of requests rejected while waiting for the limits.
+func (s LimitSnapshot) Rejected() uint64 {
+	return s.rejected
+}
+
+// WaitTime returns sum of time spent by the requests waiting for the limits.
+// Use with [time.Duration] to get human-readable value.
+func (s LimitSnapshot) WaitTime() uint64 {
+	return s.waitTime
+}
+
 type nodeMonitor struct {
 	pubKey         []byte
 	addr           string
@@ -66,6 +247,15 @@ func (c *nodeMonitor) methodsStatus() []Snapshot {
 	return result
 }
 
+func (c *nodeMonitor) resetMethods() []Snapshot {
+	result := make([]Snapshot, len(c.methods))
+	for i, val := range c.methods {
+		result[i] = val.reset()
+	}
+
+	return result
+}
+
 func (c *nodeMonitor) address() string {
 	return c.addr
 }
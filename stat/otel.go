@@ -0,0 +1,86 @@
+package stat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Names of the OpenTelemetry instruments maintained by [OTelStat].
+const (
+	OTelRequests        = "neofs.client.requests"
+	OTelErrors          = "neofs.client.errors"
+	OTelRequestDuration = "neofs.client.request.duration"
+)
+
+// Attributes of the measurements recorded by [OTelStat].
+const (
+	OTelAttributeEndpoint = attribute.Key("neofs.node.endpoint")
+	OTelAttributeMethod   = attribute.Key("neofs.method")
+)
+
+// OTelStat is an external statistic exporting [Statistic] equivalent as
+// OpenTelemetry instruments:
+//   - [OTelRequests] counter of requests;
+//   - [OTelErrors] counter of failed requests;
+//   - [OTelRequestDuration] histogram of request durations in seconds.
+//
+// All measurements have [OTelAttributeEndpoint] and [OTelAttributeMethod]
+// attributes, values of the latter are [Method] strings.
+type OTelStat struct {
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// NewOTelStatistic is a constructor for [OTelStat] creating instruments using
+// the given provider.
+func NewOTelStatistic(mp metric.MeterProvider) (*OTelStat, error) {
+	var (
+		s     OTelStat
+		err   error
+		meter = mp.Meter("github.com/nspcc-dev/neofs-sdk-go/stat")
+	)
+
+	s.requests, err = meter.Int64Counter(OTelRequests,
+		metric.WithDescription("Number of NeoFS API requests"), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, fmt.Errorf("init %s counter: %w", OTelRequests, err)
+	}
+
+	s.errors, err = meter.Int64Counter(OTelErrors,
+		metric.WithDescription("Number of failed NeoFS API requests"), metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, fmt.Errorf("init %s counter: %w", OTelErrors, err)
+	}
+
+	s.duration, err = meter.Float64Histogram(OTelRequestDuration,
+		metric.WithDescription("Duration of NeoFS API requests"), metric.WithUnit("s"))
+	if err != nil {
+		return nil, fmt.Errorf("init %s histogram: %w", OTelRequestDuration, err)
+	}
+
+	return &s, nil
+}
+
+// OperationCallback implements [stat.OperationCallback].
+func (s *OTelStat) OperationCallback(_ []byte, endpoint string, method Method, duration time.Duration, err error) {
+	if !IsMethodValid(method) {
+		return
+	}
+
+	ctx := context.Background()
+	attrs := metric.WithAttributes(OTelAttributeEndpoint.String(endpoint), OTelAttributeMethod.String(method.String()))
+
+	if duration > 0 {
+		s.requests.Add(ctx, 1, attrs)
+		s.duration.Record(ctx, duration.Seconds(), attrs)
+	}
+
+	if err != nil {
+		s.errors.Add(ctx, 1, attrs)
+	}
+}
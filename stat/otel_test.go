@@ -0,0 +1,63 @@
+package stat
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestOTelStat(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	s, err := NewOTelStatistic(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	require.NoError(t, err)
+
+	key := []byte{1, 2, 3}
+	s.OperationCallback(key, "node1:8080", MethodObjectHead, time.Second, nil)
+	s.OperationCallback(key, "node1:8080", MethodObjectHead, 3*time.Second, errors.New("any"))
+	s.OperationCallback(key, "node1:8080", MethodObjectHead, 0, errors.New("any"))
+	s.OperationCallback(key, "node2:8080", MethodObjectGet, time.Second, nil)
+	s.OperationCallback(key, "node2:8080", MethodLast, time.Second, errors.New("any"))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	ms := make(map[string]metricdata.Aggregation)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		ms[m.Name] = m.Data
+	}
+	require.Len(t, ms, 3)
+
+	head := attribute.NewSet(OTelAttributeEndpoint.String("node1:8080"), OTelAttributeMethod.String("objectHead"))
+	get := attribute.NewSet(OTelAttributeEndpoint.String("node2:8080"), OTelAttributeMethod.String("objectGet"))
+
+	counter := func(name string) map[attribute.Set]int64 {
+		res := make(map[attribute.Set]int64)
+		for _, p := range ms[name].(metricdata.Sum[int64]).DataPoints {
+			res[p.Attributes] = p.Value
+		}
+		return res
+	}
+	require.Equal(t, map[attribute.Set]int64{head: 2, get: 1}, counter(OTelRequests))
+	require.Equal(t, map[attribute.Set]int64{head: 2}, counter(OTelErrors))
+
+	hist := ms[OTelRequestDuration].(metricdata.Histogram[float64])
+	require.Len(t, hist.DataPoints, 2)
+	for _, p := range hist.DataPoints {
+		switch p.Attributes {
+		case head:
+			require.EqualValues(t, 2, p.Count)
+			require.InDelta(t, 4, p.Sum, 1e-9)
+		case get:
+			require.EqualValues(t, 1, p.Count)
+			require.InDelta(t, 1, p.Sum, 1e-9)
+		default:
+			t.Fatalf("unexpected attributes %v", p.Attributes)
+		}
+	}
+}
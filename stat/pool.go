@@ -2,6 +2,7 @@ package stat
 
 import (
 	"encoding/hex"
+	"maps"
 	"sync"
 	"time"
 )
@@ -10,8 +11,12 @@ import (
 type PoolStat struct {
 	errorThreshold uint32
 
-	mu       sync.RWMutex // protects nodeMonitor's map
+	mu       sync.RWMutex // protects nodeMonitor's and health maps, and window start; read-locked by updates
 	monitors map[string]*nodeMonitor
+	health   map[string]bool
+	since    time.Time
+
+	limits [MethodLast]limitStatus
 }
 
 // NewPoolStatistic is a constructor for [PoolStat].
@@ -19,6 +24,8 @@ func NewPoolStatistic() *PoolStat {
 	return &PoolStat{
 		mu:       sync.RWMutex{},
 		monitors: make(map[string]*nodeMonitor),
+		health:   make(map[string]bool),
+		since:    time.Now(),
 	}
 }
 
@@ -33,12 +40,60 @@ func (s *PoolStat) OperationCallback(nodeKey []byte, endpoint string, method Met
 		return
 	}
 
-	k := hex.EncodeToString(nodeKey)
+	// whole update belongs to a single statistic window, see Reset
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	mon := s.monitor(nodeKey, endpoint)
+
+	if duration > 0 {
+		mon.methods[method].incRequests(duration)
+	}
+
+	if err != nil {
+		mon.incErrorRate()
+		mon.methods[method].errors.Add(1)
+	}
+}
+
+// PayloadCallback implements [stat.PayloadCallback].
+func (s *PoolStat) PayloadCallback(nodeKey []byte, endpoint string, method Method, size uint64) {
+	if len(nodeKey) == 0 || !IsMethodValid(method) {
+		return
+	}
 
 	s.mu.RLock()
-	mon, ok := s.monitors[k]
+	s.monitor(nodeKey, endpoint).methods[method].payload.Add(size)
 	s.mu.RUnlock()
+}
+
+// LimitCallback implements [stat.LimitCallback]. Statistics are collected per
+// method for all nodes, see [Statistic.Limit].
+func (s *PoolStat) LimitCallback(_ string, method Method, wait time.Duration, err error) {
+	if !IsMethodValid(method) {
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st := &s.limits[method]
+	st.delayed.Add(1)
+	st.waitTime.Add(uint64(wait))
+	if err != nil {
+		st.rejected.Add(1)
+	}
+}
+
+// monitor returns nodeMonitor of the node with the given public key creating
+// it if needed. Must be called with s.mu read-locked, the lock is released for
+// a while to create new nodeMonitor.
+func (s *PoolStat) monitor(nodeKey []byte, endpoint string) *nodeMonitor {
+	k := hex.EncodeToString(nodeKey)
+
+	mon, ok := s.monitors[k]
 	if !ok {
+		s.mu.RUnlock()
 		s.mu.Lock()
 		mon, ok = s.monitors[k]
 		if !ok {
@@ -56,33 +111,69 @@ func (s *PoolStat) OperationCallback(nodeKey []byte, endpoint string, method Met
 			s.monitors[k] = mon
 		}
 		s.mu.Unlock()
+		s.mu.RLock()
 	}
 
-	if duration > 0 {
-		mon.methods[method].incRequests(duration)
-	}
+	return mon
+}
 
-	if err != nil {
-		mon.incErrorRate()
-	}
+// HealthCallback implements [stat.HealthCallback].
+func (s *PoolStat) HealthCallback(endpoint string, healthy bool) {
+	s.mu.Lock()
+	s.health[endpoint] = healthy
+	s.mu.Unlock()
 }
 
 // Statistic returns connection statistics.
 func (s *PoolStat) Statistic() Statistic {
-	stat := Statistic{}
-
 	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stat := s.statistic((*nodeMonitor).methodsStatus)
+	for i := range s.limits {
+		stat.limits[i] = s.limits[i].snapshot()
+	}
+	return stat
+}
+
+// Reset starts new statistic window: per-method statistics of all nodes (see
+// [NodeStatistic.Snapshot]) and limiter statistics (see [Statistic.Limit]) are
+// zeroed. Overall error counters and health
+// states are kept. Returns statistics of the finished window.
+//
+// Note that consumers of the monotonic counters, such as Prometheus, treat
+// this as a counter reset.
+func (s *PoolStat) Reset() Statistic {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stat := s.statistic((*nodeMonitor).resetMethods)
+	for i := range s.limits {
+		stat.limits[i] = s.limits[i].reset()
+	}
+	s.since = time.Now()
+	return stat
+}
+
+// statistic collects connection statistics getting per-method ones using the
+// given function. Must be called under the lock.
+func (s *PoolStat) statistic(methods func(*nodeMonitor) []Snapshot) Statistic {
+	stat := Statistic{
+		since:  s.since,
+		health: maps.Clone(s.health),
+		limits: make([]LimitSnapshot, MethodLast),
+	}
+
 	for _, mon := range s.monitors {
 		node := NodeStatistic{
 			publicKey:     mon.publicKey(),
 			address:       mon.address(),
-			methods:       mon.methodsStatus(),
+			methods:       methods(mon),
 			overallErrors: mon.overallErrorRate(),
 		}
 		stat.nodes = append(stat.nodes, node)
 		stat.overallErrors += node.overallErrors
 	}
-	s.mu.RUnlock()
 
 	return stat
 }
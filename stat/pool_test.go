@@ -67,3 +67,194 @@ func TestStatisticConcurrency(t *testing.T) {
 		require.Equal(t, uint64(n*2), node.Requests(), s.addr)
 	}
 }
+
+func TestPoolStat_Methods(t *testing.T) {
+	ps := NewPoolStatistic()
+	key := []byte{1}
+	ps.OperationCallback(key, "node1", MethodObjectHead, time.Millisecond, nil)
+	ps.OperationCallback(key, "node1", MethodObjectHead, 5*time.Millisecond, errors.New("any"))
+	ps.OperationCallback(key, "node1", MethodObjectHead, 0, errors.New("any"))
+	ps.OperationCallback(key, "node1", MethodObjectHead, time.Minute, nil)
+
+	node, err := ps.Statistic().Node("node1")
+	require.NoError(t, err)
+	snap, err := node.Snapshot(MethodObjectHead)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, snap.AllRequests())
+	require.EqualValues(t, 2, snap.Errors())
+
+	hist := snap.LatencyHistogram()
+	require.Len(t, hist, len(LatencyBuckets())+1)
+	require.EqualValues(t, 2, hist[0])
+	require.EqualValues(t, 1, hist[len(hist)-1])
+
+	snap, err = node.Snapshot(MethodObjectGet)
+	require.NoError(t, err)
+	require.Zero(t, snap.AllRequests())
+	require.Zero(t, snap.Errors())
+}
+
+func TestPoolStat_HealthCallback(t *testing.T) {
+	ps := NewPoolStatistic()
+	require.Empty(t, ps.Statistic().Health())
+
+	ps.HealthCallback("node1", true)
+	ps.HealthCallback("node2", true)
+	ps.HealthCallback("node2", false)
+	require.Equal(t, map[string]bool{"node1": true, "node2": false}, ps.Statistic().Health())
+}
+
+func TestSnapshot_Percentile(t *testing.T) {
+	require.Zero(t, Snapshot{}.P50())
+
+	ps := NewPoolStatistic()
+	key := []byte{1}
+	// 10 requests in (0, 5ms], 80 in (10ms, 25ms], 10 above all buckets.
+	for range 10 {
+		ps.OperationCallback(key, "node1", MethodObjectGet, time.Millisecond, nil)
+		ps.OperationCallback(key, "node1", MethodObjectGet, time.Minute, nil)
+	}
+	for range 80 {
+		ps.OperationCallback(key, "node1", MethodObjectGet, 20*time.Millisecond, nil)
+	}
+
+	node, err := ps.Statistic().Node("node1")
+	require.NoError(t, err)
+	snap, err := node.Snapshot(MethodObjectGet)
+	require.NoError(t, err)
+
+	require.Equal(t, time.Duration(0), snap.Percentile(0))
+	require.Equal(t, 2500*time.Microsecond, snap.Percentile(0.05))
+	require.Equal(t, 5*time.Millisecond, snap.Percentile(0.1))
+	require.Equal(t, 17500*time.Microsecond, snap.P50())
+	require.Equal(t, 25*time.Millisecond, snap.P90())
+	require.Equal(t, 10*time.Second, snap.P99())
+	require.Equal(t, 10*time.Second, snap.Percentile(2))
+}
+
+func TestPoolStat_PayloadCallback(t *testing.T) {
+	ps := NewPoolStatistic()
+	key := []byte{1}
+	ps.PayloadCallback(nil, "node1", MethodObjectPutStream, 1)
+	ps.PayloadCallback(key, "node1", MethodLast, 1)
+	ps.OperationCallback(key, "node1", MethodObjectPutStream, 2*time.Second, nil)
+	ps.PayloadCallback(key, "node1", MethodObjectPutStream, 1000)
+	ps.PayloadCallback(key, "node1", MethodObjectPutStream, 24)
+
+	node, err := ps.Statistic().Node("node1")
+	require.NoError(t, err)
+	snap, err := node.Snapshot(MethodObjectPutStream)
+	require.NoError(t, err)
+	require.EqualValues(t, 1024, snap.Payload())
+	require.InDelta(t, 512, snap.Throughput(), 1e-9)
+
+	snap, err = node.Snapshot(MethodObjectGetStream)
+	require.NoError(t, err)
+	require.Zero(t, snap.Payload())
+	require.Zero(t, snap.Throughput())
+}
+
+func TestPoolStat_Reset(t *testing.T) {
+	ps := NewPoolStatistic()
+	since := ps.Statistic().Since()
+	require.False(t, since.IsZero())
+
+	key := []byte{1}
+	ps.OperationCallback(key, "node1", MethodObjectGet, time.Second, errors.New("any"))
+	ps.PayloadCallback(key, "node1", MethodObjectGet, 100)
+	ps.HealthCallback("node1", true)
+
+	prev := ps.Reset()
+	require.Equal(t, since, prev.Since())
+	node, err := prev.Node("node1")
+	require.NoError(t, err)
+	snap, err := node.Snapshot(MethodObjectGet)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, snap.AllRequests())
+	require.EqualValues(t, 1, snap.Errors())
+	require.EqualValues(t, 100, snap.Payload())
+	require.Equal(t, time.Second, snap.Percentile(1))
+
+	cur := ps.Statistic()
+	require.False(t, cur.Since().Before(since))
+	require.EqualValues(t, 1, cur.OverallErrors())
+	require.Equal(t, map[string]bool{"node1": true}, cur.Health())
+	node, err = cur.Node("node1")
+	require.NoError(t, err)
+	snap, err = node.Snapshot(MethodObjectGet)
+	require.NoError(t, err)
+	require.Zero(t, snap.AllRequests())
+	require.Zero(t, snap.AllTime())
+	require.Zero(t, snap.Errors())
+	require.Zero(t, snap.Payload())
+	require.Zero(t, snap.P99())
+}
+
+func TestPoolStat_ResetConcurrency(t *testing.T) {
+	const n = 10000
+	ps := NewPoolStatistic()
+	key := []byte{1}
+	ps.OperationCallback(key, "node1", MethodObjectGet, 0, nil) // create node monitor
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range n {
+				ps.OperationCallback(key, "node1", MethodObjectGet, time.Millisecond, errors.New("any"))
+			}
+		})
+	}
+
+	var windows []Statistic
+	done := make(chan struct{})
+	go func() { wg.Wait(); close(done) }()
+	for loop := true; loop; {
+		select {
+		case <-done:
+			loop = false
+		default:
+		}
+		windows = append(windows, ps.Reset())
+	}
+
+	var total uint64
+	for _, w := range windows {
+		node, err := w.Node("node1")
+		require.NoError(t, err)
+		snap, err := node.Snapshot(MethodObjectGet)
+		require.NoError(t, err)
+		// update is never split between windows
+		require.Equal(t, snap.AllRequests(), snap.Errors())
+		total += snap.AllRequests()
+	}
+	require.EqualValues(t, 4*n, total)
+}
+
+func TestPoolStat_LimitCallback(t *testing.T) {
+	ps := NewPoolStatistic()
+	ps.LimitCallback("node1", MethodObjectPut, time.Second, nil)
+	ps.LimitCallback("node2", MethodObjectPut, 2*time.Second, errors.New("any"))
+	ps.LimitCallback("node1", MethodLast, time.Second, nil)
+
+	_, err := ps.Statistic().Limit(MethodLast)
+	require.Error(t, err)
+
+	l, err := ps.Statistic().Limit(MethodObjectPut)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, l.Delayed())
+	require.EqualValues(t, 1, l.Rejected())
+	require.EqualValues(t, 3*time.Second, l.WaitTime())
+
+	l, err = ps.Statistic().Limit(MethodObjectGet)
+	require.NoError(t, err)
+	require.Zero(t, l.Delayed())
+
+	l, err = ps.Reset().Limit(MethodObjectPut)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, l.Delayed())
+	l, err = ps.Statistic().Limit(MethodObjectPut)
+	require.NoError(t, err)
+	require.Zero(t, l.Delayed())
+	require.Zero(t, l.Rejected())
+	require.Zero(t, l.WaitTime())
+}
@@ -0,0 +1,145 @@
+/*
+Package promstat provides Prometheus exporter of the pool statistics collected
+by [stat.PoolStat].
+*/
+package promstat
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Names of the metrics exported by [Collector].
+const (
+	MetricRequests        = "neofs_pool_requests_total"
+	MetricErrors          = "neofs_pool_errors_total"
+	MetricRequestDuration = "neofs_pool_request_duration_seconds"
+	MetricPayload         = "neofs_pool_payload_bytes_total"
+	MetricNodeHealthy     = "neofs_pool_node_healthy"
+	MetricLimitDelayed    = "neofs_pool_limit_delayed_total"
+	MetricLimitRejected   = "neofs_pool_limit_rejected_total"
+	MetricLimitWait       = "neofs_pool_limit_wait_seconds_total"
+)
+
+// Labels of the metrics exported by [Collector].
+const (
+	LabelEndpoint = "endpoint"
+	LabelMethod   = "method"
+)
+
+// Collector is a [prometheus.Collector] exporting [stat.PoolStat] data:
+//   - [MetricRequests] counter of requests;
+//   - [MetricErrors] counter of failed requests;
+//   - [MetricRequestDuration] histogram of request durations;
+//   - [MetricPayload] counter of transmitted object payload bytes, see
+//     [stat.PoolStat.PayloadCallback];
+//   - [MetricNodeHealthy] gauge of node health states (1 if healthy, 0
+//     otherwise), see [stat.PoolStat.HealthCallback];
+//   - [MetricLimitDelayed], [MetricLimitRejected] and [MetricLimitWait]
+//     counters of requests delayed and rejected by the pool limits and time
+//     they waited, see [stat.PoolStat.LimitCallback].
+//
+// Request metrics have [LabelEndpoint] and [LabelMethod] labels, values of the
+// latter are [stat.Method] strings. They are exported only for methods called
+// at least once. Node health gauge has [LabelEndpoint] label only. Limit
+// metrics have [LabelMethod] label only and are exported for methods delayed
+// at least once.
+type Collector struct {
+	stat *stat.PoolStat
+
+	requests *prometheus.Desc
+	errors   *prometheus.Desc
+	duration *prometheus.Desc
+	payload  *prometheus.Desc
+	healthy  *prometheus.Desc
+
+	limitDelayed  *prometheus.Desc
+	limitRejected *prometheus.Desc
+	limitWait     *prometheus.Desc
+}
+
+// NewCollector is a constructor for [Collector] exporting data of the given
+// statistic. The result should be registered in the [prometheus.Registerer].
+func NewCollector(s *stat.PoolStat) *Collector {
+	labels := []string{LabelEndpoint, LabelMethod}
+	return &Collector{
+		stat:     s,
+		requests: prometheus.NewDesc(MetricRequests, "Number of NeoFS API requests.", labels, nil),
+		errors:   prometheus.NewDesc(MetricErrors, "Number of failed NeoFS API requests.", labels, nil),
+		duration: prometheus.NewDesc(MetricRequestDuration, "Duration of NeoFS API requests.", labels, nil),
+		payload:  prometheus.NewDesc(MetricPayload, "Number of object payload bytes transmitted by NeoFS API requests.", labels, nil),
+		healthy:  prometheus.NewDesc(MetricNodeHealthy, "Health state of the NeoFS node: 1 if healthy, 0 otherwise.", []string{LabelEndpoint}, nil),
+
+		limitDelayed:  prometheus.NewDesc(MetricLimitDelayed, "Number of NeoFS API requests delayed by the pool limits.", []string{LabelMethod}, nil),
+		limitRejected: prometheus.NewDesc(MetricLimitRejected, "Number of NeoFS API requests rejected while waiting for the pool limits.", []string{LabelMethod}, nil),
+		limitWait:     prometheus.NewDesc(MetricLimitWait, "Time spent by NeoFS API requests waiting for the pool limits.", []string{LabelMethod}, nil),
+	}
+}
+
+// Describe implements [prometheus.Collector].
+func (x *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- x.requests
+	ch <- x.errors
+	ch <- x.duration
+	ch <- x.payload
+	ch <- x.healthy
+	ch <- x.limitDelayed
+	ch <- x.limitRejected
+	ch <- x.limitWait
+}
+
+// Collect implements [prometheus.Collector].
+func (x *Collector) Collect(ch chan<- prometheus.Metric) {
+	s := x.stat.Statistic()
+	bounds := stat.LatencyBuckets()
+
+	for _, node := range s.Nodes() {
+		for m := range stat.MethodLast {
+			snap, err := node.Snapshot(m)
+			if err != nil || snap.AllRequests() == 0 && snap.Errors() == 0 && snap.Payload() == 0 {
+				continue
+			}
+
+			labels := []string{node.Address(), m.String()}
+			ch <- prometheus.MustNewConstMetric(x.requests, prometheus.CounterValue, float64(snap.AllRequests()), labels...)
+			ch <- prometheus.MustNewConstMetric(x.errors, prometheus.CounterValue, float64(snap.Errors()), labels...)
+
+			var (
+				hist    = snap.LatencyHistogram()
+				buckets = make(map[float64]uint64, len(bounds))
+				count   uint64
+			)
+			for i := range bounds {
+				count += hist[i]
+				buckets[bounds[i].Seconds()] = count
+			}
+			count += hist[len(bounds)]
+			ch <- prometheus.MustNewConstHistogram(x.duration, count, time.Duration(snap.AllTime()).Seconds(), buckets, labels...)
+
+			if snap.Payload() > 0 {
+				ch <- prometheus.MustNewConstMetric(x.payload, prometheus.CounterValue, float64(snap.Payload()), labels...)
+			}
+		}
+	}
+
+	for endpoint, healthy := range s.Health() {
+		var v float64
+		if healthy {
+			v = 1
+		}
+		ch <- prometheus.MustNewConstMetric(x.healthy, prometheus.GaugeValue, v, endpoint)
+	}
+
+	for m := range stat.MethodLast {
+		l, err := s.Limit(m)
+		if err != nil || l.Delayed() == 0 {
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(x.limitDelayed, prometheus.CounterValue, float64(l.Delayed()), m.String())
+		ch <- prometheus.MustNewConstMetric(x.limitRejected, prometheus.CounterValue, float64(l.Rejected()), m.String())
+		ch <- prometheus.MustNewConstMetric(x.limitWait, prometheus.CounterValue, time.Duration(l.WaitTime()).Seconds(), m.String())
+	}
+}
@@ -0,0 +1,88 @@
+package promstat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-sdk-go/stat"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector(t *testing.T) {
+	s := stat.NewPoolStatistic()
+	s.OperationCallback([]byte{1}, "grpc://node1:8080", stat.MethodObjectHead, 3*time.Millisecond, nil)
+	s.OperationCallback([]byte{1}, "grpc://node1:8080", stat.MethodObjectHead, 200*time.Millisecond, errors.New("any"))
+	s.OperationCallback([]byte{1}, "grpc://node1:8080", stat.MethodObjectHead, 0, errors.New("any"))
+	s.OperationCallback([]byte{2}, "grpc://node2:8080", stat.MethodObjectGet, 20*time.Second, nil)
+	s.PayloadCallback([]byte{2}, "grpc://node2:8080", stat.MethodObjectGet, 1024)
+	s.LimitCallback("grpc://node1:8080", stat.MethodObjectPut, time.Second, nil)
+	s.LimitCallback("grpc://node2:8080", stat.MethodObjectPut, 500*time.Millisecond, context.DeadlineExceeded)
+	s.HealthCallback("grpc://node1:8080", true)
+	s.HealthCallback("grpc://node2:8080", false)
+
+	reg := prometheus.NewPedanticRegistry()
+	require.NoError(t, reg.Register(NewCollector(s)))
+
+	const expected = `
+# HELP neofs_pool_errors_total Number of failed NeoFS API requests.
+# TYPE neofs_pool_errors_total counter
+neofs_pool_errors_total{endpoint="grpc://node1:8080",method="objectHead"} 2
+neofs_pool_errors_total{endpoint="grpc://node2:8080",method="objectGet"} 0
+# HELP neofs_pool_limit_delayed_total Number of NeoFS API requests delayed by the pool limits.
+# TYPE neofs_pool_limit_delayed_total counter
+neofs_pool_limit_delayed_total{method="objectPut"} 2
+# HELP neofs_pool_limit_rejected_total Number of NeoFS API requests rejected while waiting for the pool limits.
+# TYPE neofs_pool_limit_rejected_total counter
+neofs_pool_limit_rejected_total{method="objectPut"} 1
+# HELP neofs_pool_limit_wait_seconds_total Time spent by NeoFS API requests waiting for the pool limits.
+# TYPE neofs_pool_limit_wait_seconds_total counter
+neofs_pool_limit_wait_seconds_total{method="objectPut"} 1.5
+# HELP neofs_pool_node_healthy Health state of the NeoFS node: 1 if healthy, 0 otherwise.
+# TYPE neofs_pool_node_healthy gauge
+neofs_pool_node_healthy{endpoint="grpc://node1:8080"} 1
+neofs_pool_node_healthy{endpoint="grpc://node2:8080"} 0
+# HELP neofs_pool_payload_bytes_total Number of object payload bytes transmitted by NeoFS API requests.
+# TYPE neofs_pool_payload_bytes_total counter
+neofs_pool_payload_bytes_total{endpoint="grpc://node2:8080",method="objectGet"} 1024
+# HELP neofs_pool_request_duration_seconds Duration of NeoFS API requests.
+# TYPE neofs_pool_request_duration_seconds histogram
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.005"} 1
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.01"} 1
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.025"} 1
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.05"} 1
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.1"} 1
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.25"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="0.5"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="1"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="2.5"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="5"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="10"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node1:8080",method="objectHead",le="+Inf"} 2
+neofs_pool_request_duration_seconds_sum{endpoint="grpc://node1:8080",method="objectHead"} 0.203
+neofs_pool_request_duration_seconds_count{endpoint="grpc://node1:8080",method="objectHead"} 2
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.005"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.01"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.025"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.05"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.1"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.25"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="0.5"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="1"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="2.5"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="5"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="10"} 0
+neofs_pool_request_duration_seconds_bucket{endpoint="grpc://node2:8080",method="objectGet",le="+Inf"} 1
+neofs_pool_request_duration_seconds_sum{endpoint="grpc://node2:8080",method="objectGet"} 20
+neofs_pool_request_duration_seconds_count{endpoint="grpc://node2:8080",method="objectGet"} 1
+# HELP neofs_pool_requests_total Number of NeoFS API requests.
+# TYPE neofs_pool_requests_total counter
+neofs_pool_requests_total{endpoint="grpc://node1:8080",method="objectHead"} 2
+neofs_pool_requests_total{endpoint="grpc://node2:8080",method="objectGet"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected)))
+}
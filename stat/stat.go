@@ -40,6 +40,7 @@ const (
 	MethodObjectSearchV2
 	MethodContainerSetAttribute
 	MethodContainerRemoveAttribute
+	MethodObjectHash
 	// MethodLast is no a valid method name, it's a system anchor for tests, etc.
 	MethodLast
 )
@@ -101,6 +102,8 @@ func (m Method) String() string {
 		return "containerSetAttribute"
 	case MethodContainerRemoveAttribute:
 		return "containerRemoveAttribute"
+	case MethodObjectHash:
+		return "objectHash"
 	case MethodLast:
 		return "it's a system name rather than a method"
 	default:
@@ -118,4 +121,25 @@ type (
 	//
 	// Passing zero duration means only error counting.
 	OperationCallback = func(nodeKey []byte, endpoint string, method Method, duration time.Duration, err error)
+
+	// PayloadCallback describes common interface to external collection of
+	// the object payload bytes transmitted by the streaming operations. It is
+	// called once per stream on its completion.
+	PayloadCallback = func(nodeKey []byte, endpoint string, method Method, size uint64)
+
+	// CacheCallback describes common interface to external cache statistic
+	// collection. Hit flag is set when the method result is served from the
+	// cache without a network request.
+	CacheCallback = func(method Method, hit bool)
+
+	// HealthCallback describes common interface to external collection of the
+	// node health states. It is called with the node endpoint and its current
+	// state whenever the state is checked or changed.
+	HealthCallback = func(endpoint string, healthy bool)
+
+	// LimitCallback describes common interface to external collection of the
+	// request limiter statistics. It is called for each request to the node
+	// delayed by the limits with the time spent waiting. Non-nil error means
+	// the request has been rejected while waiting.
+	LimitCallback = func(endpoint string, method Method, wait time.Duration, err error)
 )
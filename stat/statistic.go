@@ -9,6 +9,9 @@ import (
 type Statistic struct {
 	overallErrors uint64
 	nodes         []NodeStatistic
+	health        map[string]bool
+	since         time.Time
+	limits        []LimitSnapshot
 }
 
 // OverallErrors returns sum of errors on all connections. It doesn't decrease.
@@ -24,6 +27,35 @@ func (s Statistic) Nodes() []NodeStatistic {
 	return s.nodes
 }
 
+// Since returns start time of the statistic window, i.e. when [PoolStat] was
+// created or last reset via [PoolStat.Reset].
+func (s Statistic) Since() time.Time {
+	return s.since
+}
+
+// Health returns health states of the pool nodes by their endpoints as
+// reported to [PoolStat.HealthCallback]. Nodes without reported state are
+// missing.
+//
+// The value returned shares memory with the structure itself, so changing it can lead to data corruption.
+// Make a copy if you need to change it.
+func (s Statistic) Health() map[string]bool {
+	return s.health
+}
+
+// Limit returns limiter statistic for method collected from all nodes via
+// [PoolStat.LimitCallback].
+func (s Statistic) Limit(method Method) (LimitSnapshot, error) {
+	if !IsMethodValid(method) {
+		return LimitSnapshot{}, errors.New("invalid method")
+	}
+	if s.limits == nil {
+		return LimitSnapshot{}, nil
+	}
+
+	return s.limits[method], nil
+}
+
 // ErrUnknownNode indicate that node with current address is not found in list.
 var ErrUnknownNode = errors.New("unknown node")
 
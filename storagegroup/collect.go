@@ -0,0 +1,107 @@
+package storagegroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// ObjectHeader reads object headers. Both [client.Client] and pool.Pool
+// implement it.
+type ObjectHeader interface {
+	ObjectHead(ctx context.Context, containerID cid.ID, objectID oid.ID, signer user.Signer, prm client.PrmObjectHead) (*object.Object, error)
+}
+
+// Calculate calculates StorageGroup of the objects with given headers. The
+// headers must have IDs set and belong to the same container. Member order
+// corresponds to hdrs.
+//
+// Validation data size is the sum of the member payload sizes. Validation
+// hash is calculated as Tillich-Zémor hash of the concatenated member payloads
+// from their payload homomorphic hashes. The hash is left unset if none of the
+// members has a homomorphic hash (e.g. in containers with disabled homomorphic
+// hashing), and an error is returned if only some of them do.
+func Calculate(hdrs []object.Object) (StorageGroup, error) {
+	if len(hdrs) == 0 {
+		return StorageGroup{}, errors.New("no members")
+	}
+
+	var (
+		res      StorageGroup
+		cnr      = hdrs[0].GetContainerID()
+		_, withH = hdrs[0].PayloadHomomorphicHash()
+		hashes   [][]byte
+	)
+	res.members = make([]oid.ID, len(hdrs))
+	for i := range hdrs {
+		id := hdrs[i].GetID()
+		if id.IsZero() {
+			return StorageGroup{}, fmt.Errorf("member #%d: missing ID", i)
+		}
+		for j := range i {
+			if res.members[j] == id {
+				return StorageGroup{}, fmt.Errorf("duplicated member %s", id)
+			}
+		}
+		if c := hdrs[i].GetContainerID(); c != cnr {
+			return StorageGroup{}, fmt.Errorf("member %s: container %s differs from %s", id, c, cnr)
+		}
+		res.members[i] = id
+		res.sz += hdrs[i].PayloadSize()
+
+		cs, ok := hdrs[i].PayloadHomomorphicHash()
+		if ok != withH {
+			return StorageGroup{}, fmt.Errorf("member %s: homomorphic hash presence differs from other members", id)
+		}
+		if !ok {
+			continue
+		}
+		if typ := cs.Type(); typ != checksum.TillichZemor {
+			return StorageGroup{}, fmt.Errorf("member %s: wrong homomorphic hash type %s", id, typ)
+		}
+		if ln := len(cs.Value()); ln != tz.Size {
+			return StorageGroup{}, fmt.Errorf("member %s: wrong homomorphic hash length %d, expected %d", id, ln, tz.Size)
+		}
+		hashes = append(hashes, cs.Value())
+	}
+
+	if withH {
+		h, err := tz.Concat(hashes)
+		if err != nil {
+			return StorageGroup{}, fmt.Errorf("concatenate homomorphic hashes: %w", err)
+		}
+		res.SetValidationDataHash(checksum.New(checksum.TillichZemor, h))
+	}
+
+	return res, nil
+}
+
+// Collect requests headers of the given objects from the container via
+// [ObjectHeader.ObjectHead] and calculates their StorageGroup. Objects are
+// requested sequentially with the same signer and parameters. See [Calculate]
+// for details.
+func Collect(ctx context.Context, c ObjectHeader, cnr cid.ID, members []oid.ID, signer user.Signer, prm client.PrmObjectHead) (StorageGroup, error) {
+	if len(members) == 0 {
+		return StorageGroup{}, errors.New("no members")
+	}
+
+	hdrs := make([]object.Object, len(members))
+	for i := range members {
+		hdr, err := c.ObjectHead(ctx, cnr, members[i], signer, prm)
+		if err != nil {
+			return StorageGroup{}, fmt.Errorf("head member %s: %w", members[i], err)
+		}
+		hdrs[i] = *hdr
+		hdrs[i].SetID(members[i])
+	}
+
+	return Calculate(hdrs)
+}
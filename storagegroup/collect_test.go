@@ -0,0 +1,174 @@
+package storagegroup_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/testutil"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemberHeaders(cnr cid.ID, withHash bool, payloads ...[]byte) []object.Object {
+	res := make([]object.Object, len(payloads))
+	for i := range payloads {
+		res[i].SetContainerID(cnr)
+		res[i].SetID(oidtest.ID())
+		res[i].SetPayloadSize(uint64(len(payloads[i])))
+		if withHash {
+			h := tz.Sum(payloads[i])
+			res[i].SetPayloadHomomorphicHash(checksum.New(checksum.TillichZemor, h[:]))
+		}
+	}
+	return res
+}
+
+func TestCalculate(t *testing.T) {
+	cnr := cidtest.ID()
+	payloads := [][]byte{testutil.RandByteSlice(100), testutil.RandByteSlice(200), testutil.RandByteSlice(300)}
+	fullHash := tz.Sum(bytes.Join(payloads, nil))
+
+	t.Run("with hash", func(t *testing.T) {
+		hdrs := newMemberHeaders(cnr, true, payloads...)
+
+		sg, err := storagegroup.Calculate(hdrs)
+		require.NoError(t, err)
+		require.EqualValues(t, 600, sg.ValidationDataSize())
+		require.Equal(t, []oid.ID{hdrs[0].GetID(), hdrs[1].GetID(), hdrs[2].GetID()}, sg.Members())
+		cs, ok := sg.ValidationDataHash()
+		require.True(t, ok)
+		require.Equal(t, checksum.TillichZemor, cs.Type())
+		require.Equal(t, fullHash[:], cs.Value())
+	})
+	t.Run("without hash", func(t *testing.T) {
+		hdrs := newMemberHeaders(cnr, false, payloads...)
+
+		sg, err := storagegroup.Calculate(hdrs)
+		require.NoError(t, err)
+		require.EqualValues(t, 600, sg.ValidationDataSize())
+		require.Equal(t, []oid.ID{hdrs[0].GetID(), hdrs[1].GetID(), hdrs[2].GetID()}, sg.Members())
+		_, ok := sg.ValidationDataHash()
+		require.False(t, ok)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("no members", func(t *testing.T) {
+			_, err := storagegroup.Calculate(nil)
+			require.EqualError(t, err, "no members")
+		})
+		t.Run("missing ID", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			hdrs[1].ResetID()
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, "member #1: missing ID")
+		})
+		t.Run("duplicated member", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			hdrs[2].SetID(hdrs[0].GetID())
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("duplicated member %s", hdrs[0].GetID()))
+		})
+		t.Run("container mismatch", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			otherCnr := cidtest.OtherID(cnr)
+			hdrs[1].SetContainerID(otherCnr)
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("member %s: container %s differs from %s", hdrs[1].GetID(), otherCnr, cnr))
+		})
+		t.Run("partial hashes", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			hdrs[1] = newMemberHeaders(cnr, false, payloads[1])[0]
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("member %s: homomorphic hash presence differs from other members", hdrs[1].GetID()))
+
+			hdrs = newMemberHeaders(cnr, false, payloads...)
+			hdrs[2] = newMemberHeaders(cnr, true, payloads[2])[0]
+			_, err = storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("member %s: homomorphic hash presence differs from other members", hdrs[2].GetID()))
+		})
+		t.Run("wrong hash type", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			hdrs[1].SetPayloadHomomorphicHash(checksum.NewSHA256([32]byte{1}))
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("member %s: wrong homomorphic hash type SHA256", hdrs[1].GetID()))
+		})
+		t.Run("wrong hash length", func(t *testing.T) {
+			hdrs := newMemberHeaders(cnr, true, payloads...)
+			hdrs[1].SetPayloadHomomorphicHash(checksum.New(checksum.TillichZemor, make([]byte, tz.Size-1)))
+			_, err := storagegroup.Calculate(hdrs)
+			require.EqualError(t, err, fmt.Sprintf("member %s: wrong homomorphic hash length 63, expected 64", hdrs[1].GetID()))
+		})
+	})
+}
+
+type testObjectHeader struct {
+	t      *testing.T
+	cnr    cid.ID
+	signer user.Signer
+	prm    client.PrmObjectHead
+	hdrs   map[oid.ID]object.Object
+	err    error
+}
+
+func (x testObjectHeader) ObjectHead(_ context.Context, cnr cid.ID, id oid.ID, signer user.Signer, prm client.PrmObjectHead) (*object.Object, error) {
+	require.Equal(x.t, x.cnr, cnr)
+	require.Equal(x.t, x.signer, signer)
+	require.Equal(x.t, x.prm, prm)
+	if x.err != nil {
+		return nil, x.err
+	}
+	hdr, ok := x.hdrs[id]
+	require.True(x.t, ok)
+	hdr.ResetID() // ID is not a part of the header
+	return &hdr, nil
+}
+
+func TestCollect(t *testing.T) {
+	cnr := cidtest.ID()
+	payloads := [][]byte{testutil.RandByteSlice(100), testutil.RandByteSlice(200)}
+	fullHash := tz.Sum(bytes.Join(payloads, nil))
+	hdrs := newMemberHeaders(cnr, true, payloads...)
+	members := []oid.ID{hdrs[0].GetID(), hdrs[1].GetID()}
+
+	var prm client.PrmObjectHead
+	prm.MarkLocal()
+	c := testObjectHeader{
+		t:      t,
+		cnr:    cnr,
+		signer: usertest.User(),
+		prm:    prm,
+		hdrs:   map[oid.ID]object.Object{members[0]: hdrs[0], members[1]: hdrs[1]},
+	}
+
+	sg, err := storagegroup.Collect(context.Background(), c, cnr, members, c.signer, prm)
+	require.NoError(t, err)
+	require.EqualValues(t, 300, sg.ValidationDataSize())
+	require.Equal(t, members, sg.Members())
+	cs, ok := sg.ValidationDataHash()
+	require.True(t, ok)
+	require.Equal(t, fullHash[:], cs.Value())
+
+	t.Run("no members", func(t *testing.T) {
+		_, err := storagegroup.Collect(context.Background(), c, cnr, nil, c.signer, prm)
+		require.EqualError(t, err, "no members")
+	})
+	t.Run("head failure", func(t *testing.T) {
+		c := c
+		c.err = errors.New("any error")
+		_, err := storagegroup.Collect(context.Background(), c, cnr, members, c.signer, prm)
+		require.ErrorIs(t, err, c.err)
+		require.EqualError(t, err, fmt.Sprintf("head member %s: any error", members[0]))
+	})
+}
@@ -0,0 +1,22 @@
+/*
+Package storagegroup provides features to work with information that is
+used for proof of storage in NeoFS system.
+
+StorageGroup type groups verification values for Data Audit sessions:
+
+	// receive sg info
+	sg.ValidationDataSize() // total size of the payloads of all member objects
+	sg.ValidationDataHash() // homomorphic hash of the concatenated member payloads
+	sg.Members()            // strictly ordered list of member object IDs
+
+Storage group can be calculated from the headers of its members received via
+[client.Client.ObjectHead] and stored in NeoFS as an object of
+[object.TypeStorageGroup] type:
+
+	sg, err := storagegroup.Collect(ctx, c, cnr, members, signer, client.PrmObjectHead{})
+	// ...
+	storagegroup.WriteToObject(sg, &obj)
+
+Note that storage groups are no longer used by the NeoFS audit since API 2.18.
+*/
+package storagegroup
@@ -0,0 +1,242 @@
+package storagegroup
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	neofsproto "github.com/nspcc-dev/neofs-sdk-go/internal/proto"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	protostoragegroup "github.com/nspcc-dev/neofs-sdk-go/proto/storagegroup"
+)
+
+// StorageGroup represents storage group of the NeoFS objects.
+//
+// StorageGroup is mutually compatible with [protostoragegroup.StorageGroup]
+// message. See [StorageGroup.FromProtoMessage] / [StorageGroup.ProtoMessage]
+// methods.
+//
+// Instances can be created using built-in var declaration.
+type StorageGroup struct {
+	sz      uint64
+	hashSet bool
+	hash    checksum.Checksum
+	exp     uint64
+	members []oid.ID
+}
+
+// reads StorageGroup from the storagegroup.StorageGroup message. If
+// checkFieldPresence is set, returns an error on absence of any
+// protocol-required field.
+func (sg *StorageGroup) fromProtoMessage(m *protostoragegroup.StorageGroup, checkFieldPresence bool) error {
+	var err error
+
+	if sg.hashSet = m.ValidationHash != nil; sg.hashSet {
+		if err = sg.hash.FromProtoMessage(m.ValidationHash); err != nil {
+			return fmt.Errorf("invalid hash: %w", err)
+		}
+	} else {
+		sg.hash = checksum.Checksum{}
+	}
+
+	if len(m.Members) > 0 {
+		sg.members = make([]oid.ID, len(m.Members))
+		for i := range m.Members {
+			if m.Members[i] == nil {
+				return fmt.Errorf("nil member #%d", i)
+			}
+			if err = sg.members[i].FromProtoMessage(m.Members[i]); err != nil {
+				return fmt.Errorf("invalid member #%d: %w", i, err)
+			}
+			for j := range i {
+				if sg.members[j] == sg.members[i] {
+					return fmt.Errorf("duplicated member %s", sg.members[i])
+				}
+			}
+		}
+	} else if checkFieldPresence {
+		return errors.New("missing members")
+	} else {
+		sg.members = nil
+	}
+
+	sg.sz = m.ValidationDataSize
+	sg.exp = m.ExpirationEpoch //nolint:staticcheck // must be supported still
+
+	return nil
+}
+
+// FromProtoMessage validates m according to the NeoFS API protocol and restores
+// sg from it.
+//
+// See also [StorageGroup.ProtoMessage].
+func (sg *StorageGroup) FromProtoMessage(m *protostoragegroup.StorageGroup) error {
+	return sg.fromProtoMessage(m, true)
+}
+
+// ProtoMessage converts sg into message to transmit using the NeoFS API
+// protocol.
+//
+// See also [StorageGroup.FromProtoMessage].
+func (sg StorageGroup) ProtoMessage() *protostoragegroup.StorageGroup {
+	m := &protostoragegroup.StorageGroup{
+		ValidationDataSize: sg.sz,
+		ExpirationEpoch:    sg.exp,
+	}
+	if sg.hashSet {
+		m.ValidationHash = sg.hash.ProtoMessage()
+	}
+	if len(sg.members) > 0 {
+		m.Members = make([]*refs.ObjectID, len(sg.members))
+		for i := range sg.members {
+			m.Members[i] = sg.members[i].ProtoMessage()
+		}
+	}
+	return m
+}
+
+// ValidationDataSize returns total size of the payloads
+// of objects in the storage group.
+//
+// Zero StorageGroup has 0 data size.
+//
+// See also [StorageGroup.SetValidationDataSize].
+func (sg StorageGroup) ValidationDataSize() uint64 {
+	return sg.sz
+}
+
+// SetValidationDataSize sets total size of the payloads
+// of objects in the storage group.
+//
+// See also [StorageGroup.ValidationDataSize].
+func (sg *StorageGroup) SetValidationDataSize(sz uint64) {
+	sg.sz = sz
+}
+
+// ValidationDataHash returns homomorphic hash from the
+// concatenation of the payloads of the storage group members
+// and bool that indicates checksum presence in the storage
+// group.
+//
+// Zero StorageGroup does not have validation data checksum.
+//
+// See also [StorageGroup.SetValidationDataHash].
+func (sg StorageGroup) ValidationDataHash() (checksum.Checksum, bool) {
+	return sg.hash, sg.hashSet
+}
+
+// SetValidationDataHash sets homomorphic hash from the
+// concatenation of the payloads of the storage group members.
+//
+// See also [StorageGroup.ValidationDataHash].
+func (sg *StorageGroup) SetValidationDataHash(hash checksum.Checksum) {
+	sg.hash = hash
+	sg.hashSet = true
+}
+
+// ExpirationEpoch returns last NeoFS epoch number
+// of the storage group lifetime.
+//
+// Zero StorageGroup has 0 expiration epoch.
+//
+// See also [StorageGroup.SetExpirationEpoch].
+//
+// Deprecated: use [object.AttributeExpirationEpoch] attribute of the storage
+// group object instead.
+func (sg StorageGroup) ExpirationEpoch() uint64 {
+	return sg.exp
+}
+
+// SetExpirationEpoch sets last NeoFS epoch number
+// of the storage group lifetime.
+//
+// See also [StorageGroup.ExpirationEpoch].
+//
+// Deprecated: use [object.AttributeExpirationEpoch] attribute of the storage
+// group object instead.
+func (sg *StorageGroup) SetExpirationEpoch(epoch uint64) {
+	sg.exp = epoch
+}
+
+// Members returns strictly ordered list of
+// storage group member objects.
+//
+// Zero StorageGroup has nil members value.
+//
+// The value returned shares memory with the structure itself, so changing it can lead to data corruption.
+// Make a copy if you need to change it.
+//
+// See also [StorageGroup.SetMembers].
+func (sg StorageGroup) Members() []oid.ID {
+	return sg.members
+}
+
+// SetMembers sets strictly ordered list of
+// storage group member objects. Members must be unique.
+//
+// See also [StorageGroup.Members].
+func (sg *StorageGroup) SetMembers(members []oid.ID) {
+	sg.members = members
+}
+
+// Marshal encodes StorageGroup into a binary format of the NeoFS API protocol
+// (Protocol Buffers with direct field order).
+//
+// See also [StorageGroup.Unmarshal].
+func (sg StorageGroup) Marshal() []byte {
+	return neofsproto.Marshal(sg)
+}
+
+// Unmarshal decodes NeoFS API protocol binary format into the StorageGroup
+// (Protocol Buffers with direct field order). Returns an error describing
+// a format violation.
+//
+// See also [StorageGroup.Marshal].
+func (sg *StorageGroup) Unmarshal(data []byte) error {
+	return neofsproto.UnmarshalOptional(data, sg, (*StorageGroup).fromProtoMessage)
+}
+
+// MarshalJSON encodes StorageGroup into a JSON format of the NeoFS API protocol
+// (Protocol Buffers JSON).
+//
+// See also [StorageGroup.UnmarshalJSON].
+func (sg StorageGroup) MarshalJSON() ([]byte, error) {
+	return neofsproto.MarshalJSON(sg)
+}
+
+// UnmarshalJSON decodes NeoFS API protocol JSON format into the StorageGroup
+// (Protocol Buffers JSON). Returns an error describing a format violation.
+//
+// See also [StorageGroup.MarshalJSON].
+func (sg *StorageGroup) UnmarshalJSON(data []byte) error {
+	return neofsproto.UnmarshalJSONOptional(data, sg, (*StorageGroup).fromProtoMessage)
+}
+
+// ReadFromObject decodes StorageGroup from the payload of the given object.
+// Returns an error if the object is not of [object.TypeStorageGroup] type or
+// its payload is not a valid storage group.
+//
+// See also [WriteToObject].
+func ReadFromObject(sg *StorageGroup, obj object.Object) error {
+	if typ := obj.Type(); typ != object.TypeStorageGroup {
+		return fmt.Errorf("object is not of StorageGroup type: %s", typ)
+	}
+	if err := sg.Unmarshal(obj.Payload()); err != nil {
+		return fmt.Errorf("decode storage group from payload: %w", err)
+	}
+	return nil
+}
+
+// WriteToObject writes StorageGroup to the payload of the given object and
+// sets its type to [object.TypeStorageGroup]. Other header fields, e.g.
+// container and owner, must be set by the caller.
+//
+// See also [ReadFromObject].
+func WriteToObject(sg StorageGroup, obj *object.Object) {
+	pld := sg.Marshal()
+	obj.SetType(object.TypeStorageGroup)
+	obj.SetPayload(pld)
+	obj.SetPayloadSize(uint64(len(pld)))
+}
@@ -0,0 +1,302 @@
+package storagegroup_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/proto/refs"
+	protostoragegroup "github.com/nspcc-dev/neofs-sdk-go/proto/storagegroup"
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	storagegrouptest "github.com/nspcc-dev/neofs-sdk-go/storagegroup/test"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	anyValidSize  = 9111960712925138417
+	anyValidEpoch = 7240358101469624066
+)
+
+var anyValidHash = checksum.New(checksum.TillichZemor, []byte{3, 10, 17, 24, 31, 38, 45, 52, 59, 66, 73, 80, 87, 94, 101,
+	108, 115, 122, 129, 136, 143, 150, 157, 164, 171, 178, 185, 192, 199, 206, 213, 220, 227, 234, 241, 248, 255, 6, 13, 20,
+	27, 34, 41, 48, 55, 62, 69, 76, 83, 90, 97, 104, 111, 118, 125, 132, 139, 146, 153, 160, 167, 174, 181, 188})
+
+var anyValidMembers = []oid.ID{
+	{178, 74, 58, 219, 46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44, 18, 56, 117, 173, 70, 246, 8,
+		139, 247, 174, 53, 60},
+	{229, 77, 63, 235, 2, 9, 165, 123, 116, 123, 47, 65, 22, 34, 214, 76, 45, 225, 21, 46, 135, 32, 116, 172, 67, 213, 243,
+		57, 253, 127, 179, 235},
+}
+
+// set by init.
+var validStorageGroup storagegroup.StorageGroup
+
+func init() {
+	validStorageGroup.SetValidationDataSize(anyValidSize)
+	validStorageGroup.SetValidationDataHash(anyValidHash)
+	validStorageGroup.SetExpirationEpoch(anyValidEpoch) //nolint:staticcheck // must be supported still
+	validStorageGroup.SetMembers(anyValidMembers)
+}
+
+var validBinStorageGroup = []byte{8, 241, 155, 223, 233, 219, 255, 139, 186, 126, 18, 68, 8, 1, 18, 64, 3, 10, 17, 24, 31,
+	38, 45, 52, 59, 66, 73, 80, 87, 94, 101, 108, 115, 122, 129, 136, 143, 150, 157, 164, 171, 178, 185, 192, 199, 206, 213,
+	220, 227, 234, 241, 248, 255, 6, 13, 20, 27, 34, 41, 48, 55, 62, 69, 76, 83, 90, 97, 104, 111, 118, 125, 132, 139, 146,
+	153, 160, 167, 174, 181, 188, 24, 130, 238, 223, 229, 208, 234, 186, 189, 100, 34, 34, 10, 32, 178, 74, 58, 219, 46, 3,
+	110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44, 18, 56, 117, 173, 70, 246, 8, 139, 247, 174, 53, 60, 34,
+	34, 10, 32, 229, 77, 63, 235, 2, 9, 165, 123, 116, 123, 47, 65, 22, 34, 214, 76, 45, 225, 21, 46, 135, 32, 116, 172, 67,
+	213, 243, 57, 253, 127, 179, 235}
+
+var validJSONStorageGroup = `
+{
+ "validationDataSize": "9111960712925138417",
+ "validationHash": {
+  "type": "TZ",
+  "sum": "AwoRGB8mLTQ7QklQV15lbHN6gYiPlp2kq7K5wMfO1dzj6vH4/wYNFBsiKTA3PkVMU1phaG92fYSLkpmgp661vA=="
+ },
+ "expirationEpoch": "7240358101469624066",
+ "members": [
+  {
+   "value": "sko62y4Dbn3cUe4jGwbkwb7gTSwSOHWtRvYIi/euNTw="
+  },
+  {
+   "value": "5U0/6wIJpXt0ey9BFiLWTC3hFS6HIHSsQ9XzOf1/s+s="
+  }
+ ]
+}
+`
+
+func TestStorageGroup_SetValidationDataSize(t *testing.T) {
+	var sg storagegroup.StorageGroup
+	require.Zero(t, sg.ValidationDataSize())
+
+	val := rand.Uint64()
+	sg.SetValidationDataSize(val)
+	require.EqualValues(t, val, sg.ValidationDataSize())
+	sg.SetValidationDataSize(val + 1)
+	require.EqualValues(t, val+1, sg.ValidationDataSize())
+}
+
+func TestStorageGroup_SetValidationDataHash(t *testing.T) {
+	var sg storagegroup.StorageGroup
+	_, ok := sg.ValidationDataHash()
+	require.False(t, ok)
+
+	cs := checksumtest.Checksum()
+	sg.SetValidationDataHash(cs)
+	res, ok := sg.ValidationDataHash()
+	require.True(t, ok)
+	require.Equal(t, cs, res)
+
+	csOther := checksum.New(checksum.TillichZemor, []byte("any other checksum"))
+	sg.SetValidationDataHash(csOther)
+	res, ok = sg.ValidationDataHash()
+	require.True(t, ok)
+	require.Equal(t, csOther, res)
+}
+
+func TestStorageGroup_SetExpirationEpoch(t *testing.T) {
+	var sg storagegroup.StorageGroup
+	require.Zero(t, sg.ExpirationEpoch()) //nolint:staticcheck // must be supported still
+
+	val := rand.Uint64()
+	sg.SetExpirationEpoch(val)                          //nolint:staticcheck // must be supported still
+	require.EqualValues(t, val, sg.ExpirationEpoch())   //nolint:staticcheck // must be supported still
+	sg.SetExpirationEpoch(val + 1)                      //nolint:staticcheck // must be supported still
+	require.EqualValues(t, val+1, sg.ExpirationEpoch()) //nolint:staticcheck // must be supported still
+}
+
+func TestStorageGroup_SetMembers(t *testing.T) {
+	var sg storagegroup.StorageGroup
+	require.Zero(t, sg.Members())
+
+	members := oidtest.IDs(3)
+	sg.SetMembers(members)
+	require.Equal(t, members, sg.Members())
+	membersOther := oidtest.IDs(4)
+	sg.SetMembers(membersOther)
+	require.Equal(t, membersOther, sg.Members())
+}
+
+func TestStorageGroup_FromProtoMessage(t *testing.T) {
+	m := &protostoragegroup.StorageGroup{
+		ValidationDataSize: anyValidSize,
+		ValidationHash:     &refs.Checksum{Type: refs.ChecksumType_TZ, Sum: anyValidHash.Value()},
+		ExpirationEpoch:    anyValidEpoch,
+		Members: []*refs.ObjectID{
+			{Value: anyValidMembers[0][:]},
+			{Value: anyValidMembers[1][:]},
+		},
+	}
+
+	var sg storagegroup.StorageGroup
+	require.NoError(t, sg.FromProtoMessage(m))
+	require.Equal(t, validStorageGroup, sg)
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []struct {
+			name, err string
+			corrupt   func(*protostoragegroup.StorageGroup)
+		}{
+			{name: "hash/empty", err: "invalid hash: missing value",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.ValidationHash.Sum = nil }},
+			{name: "members/missing", err: "missing members",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members = nil }},
+			{name: "members/nil element", err: "nil member #1",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members[1] = nil }},
+			{name: "members/nil value", err: "invalid member #1: invalid length 0",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members[1].Value = nil }},
+			{name: "members/wrong length", err: "invalid member #1: invalid length 31",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members[1].Value = make([]byte, 31) }},
+			{name: "members/zero", err: "invalid member #1: zero object ID",
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members[1].Value = make([]byte, 32) }},
+			{name: "members/duplicated", err: "duplicated member " + anyValidMembers[0].String(),
+				corrupt: func(m *protostoragegroup.StorageGroup) { m.Members[1] = m.Members[0] }},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				m := validStorageGroup.ProtoMessage()
+				tc.corrupt(m)
+				require.EqualError(t, new(storagegroup.StorageGroup).FromProtoMessage(m), tc.err)
+			})
+		}
+	})
+}
+
+func TestStorageGroup_ProtoMessage(t *testing.T) {
+	var sg storagegroup.StorageGroup
+
+	// zero
+	m := sg.ProtoMessage()
+	require.Zero(t, m.GetValidationDataSize())
+	require.Nil(t, m.GetValidationHash())
+	require.Zero(t, m.GetExpirationEpoch())
+	require.Zero(t, m.GetMembers())
+
+	// filled
+	m = validStorageGroup.ProtoMessage()
+	require.EqualValues(t, anyValidSize, m.GetValidationDataSize())
+	require.Equal(t, refs.ChecksumType_TZ, m.GetValidationHash().GetType())
+	require.Equal(t, anyValidHash.Value(), m.GetValidationHash().GetSum())
+	require.EqualValues(t, anyValidEpoch, m.GetExpirationEpoch())
+	require.Len(t, m.GetMembers(), len(anyValidMembers))
+	for i := range anyValidMembers {
+		require.Equal(t, anyValidMembers[i][:], m.GetMembers()[i].GetValue())
+	}
+}
+
+func TestStorageGroup_Marshal(t *testing.T) {
+	require.Equal(t, validBinStorageGroup, validStorageGroup.Marshal())
+}
+
+func TestStorageGroup_Unmarshal(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("protobuf", func(t *testing.T) {
+			err := new(storagegroup.StorageGroup).Unmarshal([]byte("Hello, world!"))
+			require.ErrorContains(t, err, "proto")
+			require.ErrorContains(t, err, "cannot parse invalid wire-format data")
+		})
+		for _, tc := range []struct {
+			name, err string
+			b         []byte
+		}{
+			{name: "hash/empty", err: "invalid hash: missing value", b: []byte{18, 2, 8, 1}},
+			{name: "members/empty value", err: "invalid member #1: invalid length 0", b: []byte{34, 34, 10, 32, 178, 74, 58, 219,
+				46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44, 18, 56, 117, 173, 70, 246, 8, 139, 247, 174,
+				53, 60, 34, 0}},
+			{name: "members/duplicated", err: "duplicated member " + anyValidMembers[0].String(), b: []byte{34, 34, 10, 32, 178,
+				74, 58, 219, 46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77, 44, 18, 56, 117, 173, 70, 246, 8, 139,
+				247, 174, 53, 60, 34, 34, 10, 32, 178, 74, 58, 219, 46, 3, 110, 125, 220, 81, 238, 35, 27, 6, 228, 193, 190, 224, 77,
+				44, 18, 56, 117, 173, 70, 246, 8, 139, 247, 174, 53, 60}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				require.EqualError(t, new(storagegroup.StorageGroup).Unmarshal(tc.b), tc.err)
+			})
+		}
+	})
+
+	var sg storagegroup.StorageGroup
+	// zero
+	require.NoError(t, sg.Unmarshal(nil))
+	require.Zero(t, sg)
+
+	// filled
+	require.NoError(t, sg.Unmarshal(validBinStorageGroup))
+	require.Equal(t, validStorageGroup, sg)
+
+	// random
+	rnd := storagegrouptest.StorageGroup()
+	require.NoError(t, sg.Unmarshal(rnd.Marshal()))
+	require.Equal(t, rnd, sg)
+}
+
+func TestStorageGroup_MarshalJSON(t *testing.T) {
+	b, err := validStorageGroup.MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, validJSONStorageGroup, string(b))
+}
+
+func TestStorageGroup_UnmarshalJSON(t *testing.T) {
+	t.Run("invalid", func(t *testing.T) {
+		t.Run("protobuf", func(t *testing.T) {
+			err := new(storagegroup.StorageGroup).UnmarshalJSON([]byte("Hello, world!"))
+			require.ErrorContains(t, err, "proto")
+			require.ErrorContains(t, err, "syntax error")
+		})
+		t.Run("members", func(t *testing.T) {
+			err := new(storagegroup.StorageGroup).UnmarshalJSON([]byte(`{"members":[{"value":"AA=="}]}`))
+			require.EqualError(t, err, "invalid member #0: invalid length 1")
+		})
+	})
+
+	var sg storagegroup.StorageGroup
+	// zero
+	require.NoError(t, sg.UnmarshalJSON([]byte("{}")))
+	require.Zero(t, sg)
+
+	// filled
+	require.NoError(t, sg.UnmarshalJSON([]byte(validJSONStorageGroup)))
+	require.Equal(t, validStorageGroup, sg)
+
+	// random
+	rnd := storagegrouptest.StorageGroup()
+	b, err := rnd.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, sg.UnmarshalJSON(b))
+	require.Equal(t, rnd, sg)
+}
+
+func TestReadFromObject(t *testing.T) {
+	var obj object.Object
+	obj.SetType(object.TypeStorageGroup)
+	obj.SetPayload(validBinStorageGroup)
+
+	var sg storagegroup.StorageGroup
+	require.NoError(t, storagegroup.ReadFromObject(&sg, obj))
+	require.Equal(t, validStorageGroup, sg)
+
+	t.Run("wrong type", func(t *testing.T) {
+		obj := obj
+		obj.SetType(object.TypeRegular)
+		require.EqualError(t, storagegroup.ReadFromObject(&sg, obj), "object is not of StorageGroup type: REGULAR")
+	})
+	t.Run("invalid payload", func(t *testing.T) {
+		obj := obj
+		obj.SetPayload([]byte{18, 2, 8, 1})
+		require.EqualError(t, storagegroup.ReadFromObject(&sg, obj), "decode storage group from payload: invalid hash: missing value")
+	})
+}
+
+func TestWriteToObject(t *testing.T) {
+	var obj object.Object
+	storagegroup.WriteToObject(validStorageGroup, &obj)
+	require.Equal(t, object.TypeStorageGroup, obj.Type())
+	require.Equal(t, validBinStorageGroup, obj.Payload())
+	require.EqualValues(t, len(validBinStorageGroup), obj.PayloadSize())
+
+	var sg storagegroup.StorageGroup
+	require.NoError(t, storagegroup.ReadFromObject(&sg, obj))
+	require.Equal(t, validStorageGroup, sg)
+}
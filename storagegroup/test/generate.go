@@ -0,0 +1,21 @@
+package storagegrouptest
+
+import (
+	"math/rand/v2"
+
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/internal/testutil"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// StorageGroup returns random storagegroup.StorageGroup.
+func StorageGroup() storagegroup.StorageGroup {
+	var sg storagegroup.StorageGroup
+	sg.SetValidationDataSize(rand.Uint64())
+	sg.SetValidationDataHash(checksum.New(checksum.TillichZemor, testutil.RandByteSlice(tz.Size)))
+	sg.SetMembers(oidtest.IDs(1 + rand.IntN(3)))
+
+	return sg
+}
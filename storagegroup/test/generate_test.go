@@ -0,0 +1,29 @@
+package storagegrouptest_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-sdk-go/storagegroup"
+	storagegrouptest "github.com/nspcc-dev/neofs-sdk-go/storagegroup/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageGroup(t *testing.T) {
+	sg := storagegrouptest.StorageGroup()
+	require.NotEqual(t, sg, storagegrouptest.StorageGroup())
+
+	m := sg.ProtoMessage()
+	var sg2 storagegroup.StorageGroup
+	require.NoError(t, sg2.FromProtoMessage(m))
+	require.Equal(t, sg, sg2)
+
+	var sg3 storagegroup.StorageGroup
+	require.NoError(t, sg3.Unmarshal(sg.Marshal()))
+	require.Equal(t, sg, sg3)
+
+	j, err := sg.MarshalJSON()
+	require.NoError(t, err)
+	var sg4 storagegroup.StorageGroup
+	require.NoError(t, sg4.UnmarshalJSON(j))
+	require.Equal(t, sg, sg4)
+}